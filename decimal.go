@@ -4,8 +4,14 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"io"
+	"iter"
 	"math"
+	"math/bits"
 	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
 	"unsafe"
 )
 
@@ -35,6 +41,8 @@ var (
 	Thousand            = MustNew(1_000, 0)                      // Thousand represents the decimal value of 1,000.
 	E                   = MustNew(2_718_281_828_459_045_235, 18) // E represents Euler’s number rounded to 18 digits.
 	Pi                  = MustNew(3_141_592_653_589_793_238, 18) // Pi represents the value of π rounded to 18 digits.
+	ErrOutOfRange       = errors.New("value out of range")       // ErrOutOfRange is returned by Validate when a decimal is outside the allowed bounds.
+	ErrPrecisionLoss    = errors.New("precision loss")           // ErrPrecisionLoss is returned when non-zero digits after the decimal point would be dropped.
 	errDecimalOverflow  = errors.New("decimal overflow")
 	errInvalidDecimal   = errors.New("invalid decimal")
 	errScaleRange       = errors.New("scale out of range")
@@ -158,6 +166,52 @@ func New(value int64, scale int) (Decimal, error) {
 	return newSafe(neg, coef, scale)
 }
 
+// NewFromFixed returns a decimal equal to value / 10^scale.
+// Unlike [New], it also accepts a negative scale, in which case the value
+// is multiplied by 10^(-scale):
+//
+//	NewFromFixed(12345, 2)  = 123.45
+//	NewFromFixed(12345, -2) = 1234500
+//
+// This representation is used in FIX protocol and ISO 20022 messages.
+//
+// NewFromFixed returns an error if:
+//   - the scale is greater than [MaxScale];
+//   - the integer part of the result has more than [MaxPrec] digits.
+func NewFromFixed(value int64, scale int) (Decimal, error) {
+	if scale >= 0 {
+		d, err := New(value, scale)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("converting fixed-point integer: %w", err)
+		}
+		return d, nil
+	}
+	d, err := New(value, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("converting fixed-point integer: %w", err) // should never happen
+	}
+	if d.IsZero() {
+		return d, nil
+	}
+	coef, ok := d.coef.lsh(-scale)
+	if !ok {
+		return Decimal{}, fmt.Errorf("converting fixed-point integer: %w", errDecimalOverflow)
+	}
+	return newUnsafe(d.IsNeg(), coef, 0), nil
+}
+
+// NewFromMicros returns a decimal equal to value / 1,000,000 with
+// exactly 6 digits after the decimal point:
+//
+//	NewFromMicros(1234500) = 1.234500
+//
+// This representation is used for prices by some exchanges and market
+// data feeds.
+// See also constructor [NewFromFixed] and method [Decimal.AsMicros].
+func NewFromMicros(value int64) Decimal {
+	return MustNew(value, 6)
+}
+
 // NewFromInt64 converts a pair of integers, representing the whole and
 // fractional parts, to a (possibly rounded) decimal equal to whole + frac / 10^scale.
 // NewFromInt64 removes all trailing zeros from the fractional part.
@@ -247,6 +301,65 @@ func (d Decimal) Int64(scale int) (whole, frac int64, ok bool) {
 	return int64(q), int64(r), true
 }
 
+// AsMicros returns the decimal multiplied by 1,000,000 and truncated to
+// an integer using [rounding toward zero]:
+//
+//	1.2345678.AsMicros() = 1234567
+//
+// It is a shorthand for d.ToFixed(6).
+// See also constructor [NewFromMicros].
+//
+// AsMicros returns an error if the result cannot be represented as an int64.
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (d Decimal) AsMicros() (int64, error) {
+	return d.ToFixed(6)
+}
+
+// ToFixed returns the decimal multiplied by 10^scale and truncated to an integer
+// using [rounding toward zero].
+// A negative scale divides the decimal by 10^(-scale) instead:
+//
+//	123.456.ToFixed(2)  = 12345
+//	123.456.ToFixed(-2) = 1
+//
+// See also constructor [NewFromFixed].
+//
+// ToFixed returns an error if the result cannot be represented as an int64.
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (d Decimal) ToFixed(scale int) (int64, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return 0, nil
+	}
+
+	// General case
+	coef := d.coef
+	switch {
+	case scale < d.Scale():
+		coef = coef.rshDown(d.Scale() - scale)
+	case scale > d.Scale():
+		var ok bool
+		coef, ok = coef.lsh(scale - d.Scale())
+		if !ok {
+			return 0, fmt.Errorf("converting %v to fixed-point integer: %w", d, errDecimalOverflow)
+		}
+	}
+	if d.IsNeg() {
+		if coef > -math.MinInt64 {
+			return 0, fmt.Errorf("converting %v to fixed-point integer: %w", d, errDecimalOverflow)
+		}
+		//nolint:gosec
+		return -int64(coef), nil
+	}
+	if coef > math.MaxInt64 {
+		return 0, fmt.Errorf("converting %v to fixed-point integer: %w", d, errDecimalOverflow)
+	}
+	//nolint:gosec
+	return int64(coef), nil
+}
+
 // NewFromFloat64 converts a float to a (possibly rounded) decimal.
 // See also method [Decimal.Float64].
 //
@@ -296,6 +409,32 @@ func MustParse(s string) Decimal {
 	return d
 }
 
+// OrZero returns the decimal if err is nil, and [Zero] otherwise.
+// It simplifies one-line construction when the error can be ignored:
+//
+//	price := decimal.OrZero(decimal.Parse(s))
+//
+// See also function [OrPanic].
+func OrZero(d Decimal, err error) Decimal {
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+// OrPanic returns the decimal if err is nil, and panics otherwise.
+// It simplifies one-line construction in tests and initialization code:
+//
+//	price := decimal.OrPanic(decimal.NewFromFloat64(f))
+//
+// See also function [OrZero].
+func OrPanic(d Decimal, err error) Decimal {
+	if err != nil {
+		panic(fmt.Sprintf("OrPanic failed: %v", err))
+	}
+	return d
+}
+
 // Parse converts a string to a (possibly rounded) decimal.
 // The input string must be in one of the following formats:
 //
@@ -327,6 +466,21 @@ func Parse(s string) (Decimal, error) {
 	return parseExact(text, 0)
 }
 
+// ParseInto is like [Parse] but stores the result in dst.
+// It is useful in hot loops that repeatedly parse strings into the same
+// variable.
+// If the string cannot be parsed, dst is left unchanged.
+//
+// ParseInto returns an error under the same conditions as [Parse].
+func ParseInto(dst *Decimal, s string) error {
+	d, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*dst = d
+	return nil
+}
+
 func parse(text []byte) (Decimal, error) {
 	return parseExact(text, 0)
 }
@@ -341,6 +495,148 @@ func ParseExact(s string, scale int) (Decimal, error) {
 	return parseExact(text, scale)
 }
 
+// ParseLenient is similar to [Parse], but it also accepts underscores between
+// digits, following the convention of Go numeric literals:
+//
+//	1_000_000.50
+//	0.000_001
+//
+// An underscore must be preceded and followed by a digit.
+// Underscores do not count toward the length limit of the string.
+func ParseLenient(s string) (Decimal, error) {
+	text := make([]byte, 0, len(s))
+	for i := range len(s) {
+		if s[i] != '_' {
+			text = append(text, s[i])
+			continue
+		}
+		if i == 0 || i == len(s)-1 || !isDigit(s[i-1]) || !isDigit(s[i+1]) {
+			return Decimal{}, fmt.Errorf("parsing decimal: %w: unexpected character %q", errInvalidDecimal, s[i])
+		}
+	}
+	return parseExact(text, 0)
+}
+
+// ParseEuropean is similar to [Parse], but it expects the European notation,
+// where a comma separates the fractional part and periods optionally separate
+// groups of three digits in the integer part:
+//
+//	1.234.567,89
+//	1234567,89
+//	-0,5
+//
+// ParseEuropean does not support exponential notation.
+//
+// ParseEuropean returns an error if:
+//   - the string contains more than one comma;
+//   - a period appears after the comma;
+//   - the digit groups separated by periods are not of three digits;
+//   - the string cannot be parsed by [Parse] after the separators are replaced.
+func ParseEuropean(s string) (Decimal, error) {
+	text := make([]byte, 0, len(s))
+	pos := 0
+
+	// Sign
+	if pos < len(s) && (s[pos] == '-' || s[pos] == '+') {
+		text = append(text, s[pos])
+		pos++
+	}
+
+	// Integer part
+	group, grouped := 0, false
+	for ; pos < len(s) && s[pos] != ','; pos++ {
+		switch {
+		case isDigit(s[pos]):
+			text = append(text, s[pos])
+			group++
+		case s[pos] == '.':
+			if (grouped && group != 3) || (!grouped && (group == 0 || group > 3)) {
+				return Decimal{}, fmt.Errorf("parsing decimal: %w: misplaced thousands separator", errInvalidDecimal)
+			}
+			group, grouped = 0, true
+		default:
+			return Decimal{}, fmt.Errorf("parsing decimal: %w: unexpected character %q", errInvalidDecimal, s[pos])
+		}
+	}
+	if grouped && group != 3 {
+		return Decimal{}, fmt.Errorf("parsing decimal: %w: misplaced thousands separator", errInvalidDecimal)
+	}
+
+	// Fractional part
+	if pos < len(s) {
+		text = append(text, '.')
+		for pos++; pos < len(s); pos++ {
+			if !isDigit(s[pos]) {
+				return Decimal{}, fmt.Errorf("parsing decimal: %w: unexpected character %q", errInvalidDecimal, s[pos])
+			}
+			text = append(text, s[pos])
+		}
+	}
+
+	return parseExact(text, 0)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// NewFromReader reads a numeric string from r and parses it.
+// Reading stops at the first byte that cannot be part of a numeric string
+// (see [Parse] for the grammar) or at [io.EOF].
+// If r implements [io.ByteScanner], the terminating byte is unread,
+// otherwise it is consumed.
+// At most 331 bytes are read from r.
+//
+// NewFromReader returns an error if:
+//   - r returns an error other than [io.EOF];
+//   - the numeric string is longer than 330 bytes;
+//   - the numeric string cannot be parsed by [Parse].
+func NewFromReader(r io.Reader) (Decimal, error) {
+	var buf [331]byte
+	text, err := readNumeric(r, buf[:0])
+	if err != nil {
+		return Decimal{}, fmt.Errorf("reading decimal: %w", err)
+	}
+	return parse(text)
+}
+
+// readNumeric appends bytes from r to text while they can be part of
+// a numeric string, reading at most 331 bytes.
+func readNumeric(r io.Reader, text []byte) ([]byte, error) {
+	bs, _ := r.(io.ByteScanner)
+	var b [1]byte
+	for len(text) <= 330 {
+		var c byte
+		var err error
+		if bs != nil {
+			c, err = bs.ReadByte()
+		} else {
+			_, err = io.ReadFull(r, b[:])
+			c = b[0]
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !isNumeric(c) {
+			if bs != nil {
+				if err := bs.UnreadByte(); err != nil {
+					return nil, err
+				}
+			}
+			break
+		}
+		text = append(text, c)
+	}
+	return text, nil
+}
+
+func isNumeric(c byte) bool {
+	return isDigit(c) || c == '.' || c == '-' || c == '+' || c == 'e' || c == 'E'
+}
+
 func parseExact(text []byte, scale int) (Decimal, error) {
 	if len(text) > 330 {
 		return Decimal{}, fmt.Errorf("parsing decimal: %w", errInvalidDecimal)
@@ -551,6 +847,315 @@ func (d Decimal) String() string {
 	return string(d.bytes())
 }
 
+// Locale describes the separators used by [Decimal.StringLocale].
+type Locale struct {
+	DecimalSep         rune // DecimalSep separates the integer and fractional parts. Zero means '.'.
+	GroupSep           rune // GroupSep separates groups of integer digits. Zero disables grouping.
+	GroupSize          int  // GroupSize is the number of digits in the rightmost group.
+	SecondaryGroupSize int  // SecondaryGroupSize is the number of digits in the other groups. Zero means GroupSize.
+}
+
+var (
+	LocaleUS = Locale{DecimalSep: '.', GroupSep: ',', GroupSize: 3}                        // LocaleUS formats decimals as 1,234,567.89.
+	LocaleEU = Locale{DecimalSep: ',', GroupSep: '.', GroupSize: 3}                        // LocaleEU formats decimals as 1.234.567,89.
+	LocaleIN = Locale{DecimalSep: '.', GroupSep: ',', GroupSize: 3, SecondaryGroupSize: 2} // LocaleIN formats decimals as 12,34,567.89.
+)
+
+// StringLocale returns a string representation of the decimal
+// using the separators of the given locale:
+//
+//	1234567.89.StringLocale(LocaleUS) = 1,234,567.89
+//	1234567.89.StringLocale(LocaleEU) = 1.234.567,89
+//	1234567.89.StringLocale(LocaleIN) = 12,34,567.89
+//
+// Apart from the separators, the result is the same as that of [Decimal.String].
+// The zero Locale formats decimals in the same way as [Decimal.String].
+func (d Decimal) StringLocale(loc Locale) string {
+	s := d.String()
+	text := make([]byte, 0, 2*len(s))
+
+	// Sign
+	if d.IsNeg() {
+		text = append(text, '-')
+		s = s[1:]
+	}
+
+	// Integer part
+	intpart, fracpart := s, ""
+	if d.Scale() > 0 {
+		intpart, fracpart = s[:len(s)-d.Scale()-1], s[len(s)-d.Scale():]
+	}
+	if loc.GroupSep == 0 || loc.GroupSize <= 0 || len(intpart) <= loc.GroupSize {
+		text = append(text, intpart...)
+	} else {
+		size := loc.SecondaryGroupSize
+		if size <= 0 {
+			size = loc.GroupSize
+		}
+		head := len(intpart) - loc.GroupSize
+		pos := head % size
+		if pos == 0 {
+			pos = size
+		}
+		text = append(text, intpart[:pos]...)
+		for ; pos < head; pos += size {
+			text = utf8.AppendRune(text, loc.GroupSep)
+			text = append(text, intpart[pos:pos+size]...)
+		}
+		text = utf8.AppendRune(text, loc.GroupSep)
+		text = append(text, intpart[head:]...)
+	}
+
+	// Fractional part
+	if d.Scale() > 0 {
+		text = utf8.AppendRune(text, loc.decimalSep())
+		text = append(text, fracpart...)
+	}
+
+	return string(text)
+}
+
+// decimalSep returns the decimal separator of the locale, defaulting to '.'.
+func (loc Locale) decimalSep() rune {
+	if loc.DecimalSep == 0 {
+		return '.'
+	}
+	return loc.DecimalSep
+}
+
+// StrFormat returns a string representation of the decimal formatted
+// according to a spreadsheet-style pattern:
+//
+//	1234.5.StrFormat("#,##0.00")  = 1,234.50
+//	1234.5.StrFormat("0000.###")  = 1234.5
+//	0.125.StrFormat("#.##")       = .12
+//	-7.StrFormat("$#,##0.00 USD") = -$7.00 USD
+//
+// The pattern uses the following characters:
+//
+//	| Char | Description                                  |
+//	| ---- | -------------------------------------------- |
+//	| 0    | Digit, zero is shown if absent               |
+//	| #    | Digit, nothing is shown if absent            |
+//	| .    | Decimal separator                            |
+//	| ,    | Grouping separator                           |
+//	| '    | Quotes literal characters                    |
+//
+// The number of '0' and '#' characters after the decimal separator sets the
+// maximum number of digits after the decimal point, and the decimal is
+// rounded to it using [rounding half to even] (banker's rounding).
+// The integer part is never truncated, and its group size is the number of
+// digit characters after the last grouping separator.
+// The number part of the pattern starts at the first '0', '#', '.', or ','
+// and ends at the first character other than these.
+// Any characters before or after the number part are copied literally,
+// with the minus sign placed in front of them.
+// Characters enclosed in single quotes are also copied literally, so that
+// the pattern characters can be used in the prefix, and two single quotes
+// stand for a single quote itself:
+//
+//	12.StrFormat("'No.' 000")   = No. 012
+//	5.StrFormat("0 'o''clock'") = 5 o'clock
+//
+// See also methods [Decimal.StringLocale], [Decimal.Format].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) StrFormat(pattern string) string {
+	// Pattern
+	prefix, pattern := cutLiteral(pattern, true)
+	end := 0
+	for end < len(pattern) && strings.IndexByte("0#.,", pattern[end]) >= 0 {
+		end++
+	}
+	number := pattern[:end]
+	suffix, _ := cutLiteral(pattern[end:], false)
+	intpat, fracpat, _ := strings.Cut(number, ".")
+	minInt := strings.Count(intpat, "0")
+	minFrac := strings.Count(fracpat, "0")
+	maxFrac := minFrac + strings.Count(fracpat, "#")
+	group := 0
+	if pos := strings.LastIndexByte(intpat, ','); pos >= 0 {
+		group = len(intpat) - pos - 1
+	}
+
+	// Rounding
+	d = d.Round(maxFrac).Trim(minFrac)
+	s := d.String()
+	if d.IsNeg() {
+		s = s[1:]
+	}
+	intpart, fracpart, _ := strings.Cut(s, ".")
+	if intpart == "0" && minInt == 0 && (fracpart != "" || minFrac > 0) {
+		intpart = ""
+	}
+	text := make([]byte, 0, len(pattern)+2*len(s))
+
+	// Sign and prefix
+	if d.IsNeg() {
+		text = append(text, '-')
+	}
+	text = append(text, prefix...)
+
+	// Integer part
+	for i := len(intpart); i < minInt; i++ {
+		intpart = "0" + intpart
+	}
+	for i := range len(intpart) {
+		if i > 0 && group > 0 && (len(intpart)-i)%group == 0 {
+			text = append(text, ',')
+		}
+		text = append(text, intpart[i])
+	}
+
+	// Fractional part
+	if fracpart != "" || minFrac > 0 {
+		text = append(text, '.')
+		text = append(text, fracpart...)
+		for i := len(fracpart); i < minFrac; i++ {
+			text = append(text, '0')
+		}
+	}
+
+	// Suffix
+	text = append(text, suffix...)
+
+	return string(text)
+}
+
+// cutLiteral removes the quoting from the literal at the beginning of
+// a [Decimal.StrFormat] pattern.
+// If number is set, the literal ends at the first unquoted '0', '#', '.',
+// or ',', and the rest of the pattern is returned as well.
+func cutLiteral(pattern string, number bool) ([]byte, string) {
+	var lit []byte
+	quoted := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\'' && i+1 < len(pattern) && pattern[i+1] == '\'':
+			lit = append(lit, c)
+			i++
+		case c == '\'':
+			quoted = !quoted
+		case number && !quoted && strings.IndexByte("0#.,", c) >= 0:
+			return lit, pattern[i:]
+		default:
+			lit = append(lit, c)
+		}
+	}
+	return lit, ""
+}
+
+// CurrencyPosition determines where [Formatter] places the currency.
+type CurrencyPosition int8
+
+const (
+	CurrencyBefore CurrencyPosition = iota // CurrencyBefore places the currency before the number, as in $1.00.
+	CurrencyAfter                          // CurrencyAfter places the currency after the number, as in 1.00 USD.
+)
+
+// Formatter is a reusable set of formatting options for decimals.
+// Formatters are built by chaining option methods, each of which returns
+// an updated copy:
+//
+//	f := decimal.NewFormatter().Precision(2).GroupSeparator(',').Currency("$", decimal.CurrencyBefore)
+//	f.Format(decimal.MustParse("-1234.5")) = -$1,234.50
+//
+// The zero value is equivalent to the result of [NewFormatter].
+// A Formatter is an immutable value, so it is safe for concurrent use
+// by multiple goroutines.
+// See also methods [Decimal.StringLocale], [Decimal.StrFormat].
+type Formatter struct {
+	loc      Locale
+	round    bool // round is set if decimals are rounded to scale.
+	scale    int
+	currency string
+	pos      CurrencyPosition
+}
+
+// NewFormatter returns a formatter that produces the same result as
+// [Decimal.String]: the actual scale of the decimal is kept, '.' is used
+// as the decimal separator, and digits are not grouped.
+func NewFormatter() Formatter {
+	return Formatter{}
+}
+
+// Precision returns a formatter that rounds decimals to the given number of
+// digits after the decimal point using [rounding half to even]
+// (banker's rounding), and zero-pads them if they have fewer digits.
+// If the given precision is negative, the actual scale of the decimal is kept.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (f Formatter) Precision(scale int) Formatter {
+	f.round = scale >= 0
+	f.scale = max(scale, 0)
+	return f
+}
+
+// GroupSeparator returns a formatter that separates groups of three integer
+// digits with the given rune.
+// Zero disables grouping.
+func (f Formatter) GroupSeparator(sep rune) Formatter {
+	f.loc.GroupSep = sep
+	f.loc.GroupSize = 3
+	return f
+}
+
+// DecimalPoint returns a formatter that separates the integer and fractional
+// parts with the given rune.
+// Zero means '.'.
+func (f Formatter) DecimalPoint(sep rune) Formatter {
+	f.loc.DecimalSep = sep
+	return f
+}
+
+// Currency returns a formatter that adds the given currency at the given
+// position.
+// The currency is inserted as is, so any space between the currency and
+// the number must be a part of it, as in " USD".
+// The minus sign of negative decimals is placed in front of the currency.
+func (f Formatter) Currency(currency string, pos CurrencyPosition) Formatter {
+	f.currency = currency
+	f.pos = pos
+	return f
+}
+
+// Format returns a string representation of the decimal using
+// the options of the formatter.
+func (f Formatter) Format(d Decimal) string {
+	if f.round {
+		d = d.Round(f.scale)
+	}
+	s := d.Abs().StringLocale(f.loc)
+	text := make([]byte, 0, len(s)+len(f.currency)+max(f.scale-d.Scale(), 0)+2)
+
+	// Sign and currency
+	if d.IsNeg() {
+		text = append(text, '-')
+	}
+	if f.pos == CurrencyBefore {
+		text = append(text, f.currency...)
+	}
+
+	// Number
+	text = append(text, s...)
+	if f.round && f.scale > d.Scale() {
+		if d.Scale() == 0 {
+			text = utf8.AppendRune(text, f.loc.decimalSep())
+		}
+		for range f.scale - d.Scale() {
+			text = append(text, '0')
+		}
+	}
+
+	// Currency
+	if f.pos == CurrencyAfter {
+		text = append(text, f.currency...)
+	}
+
+	return string(text)
+}
+
 // bytes returns a string representation of the decimal as a byte slice.
 func (d Decimal) bytes() []byte {
 	text := make([]byte, 0, 24)
@@ -632,6 +1237,36 @@ func (d Decimal) MarshalJSON() ([]byte, error) {
 	return text, nil
 }
 
+// WriteTo implements the [io.WriterTo] interface.
+// WriteTo writes the decimal to w as a numeric string without converting it
+// to a string first.
+// It still allocates, because the buffer passed to w escapes to the heap.
+// See also method [Decimal.String].
+//
+// [io.WriterTo]: https://pkg.go.dev/io#WriterTo
+func (d Decimal) WriteTo(w io.Writer) (int64, error) {
+	var buf [24]byte
+	n, err := w.Write(d.append(buf[:0]))
+	if err != nil {
+		return int64(n), fmt.Errorf("writing %v: %w", d, err)
+	}
+	return int64(n), nil
+}
+
+// Set implements the [flag.Value] interface, so that a decimal can be used
+// as a command-line flag:
+//
+//	var minPrice decimal.Decimal
+//	flag.Var(&minPrice, "min-price", "minimum acceptable price")
+//
+// The decimal is left unchanged if s cannot be parsed.
+// See also constructor [Parse].
+//
+// [flag.Value]: https://pkg.go.dev/flag#Value
+func (d *Decimal) Set(s string) error {
+	return ParseInto(d, s)
+}
+
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
 // UnmarshalText supports only numeric strings.
 // See also constructor [Parse].
@@ -678,6 +1313,53 @@ func (d *Decimal) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// ScanBinary reads a decimal in the [Decimal.MarshalBinary] format from r.
+// Since the format is a numeric string without any framing, a stream of
+// decimals must separate consecutive values with whitespace:
+//
+//	1.5 -2
+//	0.25
+//
+// ScanBinary skips leading whitespace and stops at the first byte that
+// cannot be part of a numeric string, without buffering the rest of
+// the stream.
+// If r implements [io.ByteScanner], that byte is left unread.
+// See also constructor [NewFromReader].
+//
+// ScanBinary returns an error if:
+//   - the stream ends before a value, in which case the error wraps [io.EOF];
+//   - the value cannot be parsed under the same conditions as [NewFromReader].
+func (d *Decimal) ScanBinary(r io.Reader) error {
+	var buf [331]byte
+	var b [1]byte
+
+	// Leading whitespace
+	for {
+		_, err := io.ReadFull(r, b[:])
+		if err != nil {
+			return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+		}
+		if !isSpace(b[0]) {
+			break
+		}
+	}
+
+	// Value
+	text, err := readNumeric(r, append(buf[:0], b[0]))
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	*d, err = parse(text)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	return nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
 // AppendBinary implements the [encoding.BinaryAppender] interface.
 // AppendBinary always appends a numeric string.
 // See also method [Decimal.String].
@@ -696,20 +1378,53 @@ func (d Decimal) MarshalBinary() ([]byte, error) {
 	return d.bytes(), nil
 }
 
-// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
-// UnmarshalBSONValue supports the following [types]: Double, String, 32-bit Integer, 64-bit Integer, and [Decimal128].
+// UnmarshalYAML implements the [yaml.Unmarshaler] interface.
+// UnmarshalYAML supports numeric and string scalars, and ignores null.
+// The interface is supported by both gopkg.in/yaml.v2 and gopkg.in/yaml.v3,
+// so the package does not depend on either of them.
+// See also constructor [Parse].
 //
-// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
-// [types]: https://bsonspec.org/spec.html
-// [Decimal128]: https://github.com/mongodb/specifications/blob/master/source/bson-decimal128/decimal128.md
-func (d *Decimal) UnmarshalBSONValue(typ byte, data []byte) error {
-	// constants are from https://bsonspec.org/spec.html
+// [yaml.Unmarshaler]: https://pkg.go.dev/gopkg.in/yaml.v2#Unmarshaler
+func (d *Decimal) UnmarshalYAML(unmarshal func(any) error) error {
+	var s *string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	if s == nil {
+		return nil
+	}
 	var err error
-	switch typ {
-	case 1:
-		*d, err = parseBSONFloat64(data)
-	case 2:
-		*d, err = parseBSONString(data)
+	*d, err = Parse(*s)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	return nil
+}
+
+// MarshalYAML implements the [yaml.Marshaler] interface.
+// MarshalYAML always returns a string, which is encoded as a quoted scalar
+// with the !!str tag to prevent conversion to a binary floating-point number.
+// See also method [Decimal.String].
+//
+// [yaml.Marshaler]: https://pkg.go.dev/gopkg.in/yaml.v3#Marshaler
+func (d Decimal) MarshalYAML() (any, error) {
+	return d.String(), nil
+}
+
+// UnmarshalBSONValue implements the [v2/bson.ValueUnmarshaler] interface.
+// UnmarshalBSONValue supports the following [types]: Double, String, 32-bit Integer, 64-bit Integer, and [Decimal128].
+//
+// [v2/bson.ValueUnmarshaler]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson#ValueUnmarshaler
+// [types]: https://bsonspec.org/spec.html
+// [Decimal128]: https://github.com/mongodb/specifications/blob/master/source/bson-decimal128/decimal128.md
+func (d *Decimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	// constants are from https://bsonspec.org/spec.html
+	var err error
+	switch typ {
+	case 1:
+		*d, err = parseBSONFloat64(data)
+	case 2:
+		*d, err = parseBSONString(data)
 	case 10:
 		// null, do nothing
 	case 16:
@@ -887,6 +1602,374 @@ func (d Decimal) ieeeDecimal128() []byte {
 	return buf[:]
 }
 
+// EncodeProto returns the decimal encoded as a [google.type.Decimal] protobuf
+// message, where the string representation of the decimal is stored in field 1.
+// See also method [Decimal.String].
+//
+// [google.type.Decimal]: https://github.com/googleapis/googleapis/blob/master/google/type/decimal.proto
+func (d Decimal) EncodeProto() []byte {
+	text := make([]byte, 0, 24)
+	text = d.append(text)
+	data := make([]byte, 0, len(text)+2)
+	data = append(data, 0x0a) // field 1, wire type 2
+	data = appendProtoVarint(data, uint64(len(text)))
+	return append(data, text...)
+}
+
+// DecodeProto decodes a [google.type.Decimal] protobuf message into the decimal.
+// Unknown fields are skipped, and if field 1 occurs more than once,
+// the last occurrence is used.
+// See also constructor [Parse].
+//
+// DecodeProto returns an error if:
+//   - the message is malformed;
+//   - the message does not contain field 1;
+//   - field 1 is not a valid decimal.
+//
+// [google.type.Decimal]: https://github.com/googleapis/googleapis/blob/master/google/type/decimal.proto
+func (d *Decimal) DecodeProto(b []byte) error {
+	var err error
+	*d, err = parseProto(b)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	return nil
+}
+
+// parseProto parses a google.type.Decimal protobuf message to a (possibly rounded) decimal.
+func parseProto(data []byte) (Decimal, error) {
+	var text []byte
+	found := false
+	for len(data) > 0 {
+		key, n := parseProtoVarint(data)
+		if n == 0 {
+			return Decimal{}, fmt.Errorf("%w: invalid field key", errInvalidDecimal)
+		}
+		data = data[n:]
+		field, typ := key>>3, key&0b111
+		switch typ {
+		case 0: // varint
+			_, n = parseProtoVarint(data)
+			if n == 0 {
+				return Decimal{}, fmt.Errorf("%w: invalid varint in field %v", errInvalidDecimal, field)
+			}
+		case 1: // 64-bit
+			n = 8
+		case 2: // length-delimited
+			var l uint64
+			l, n = parseProtoVarint(data)
+			if n == 0 || l > uint64(len(data)-n) {
+				return Decimal{}, fmt.Errorf("%w: invalid length in field %v", errInvalidDecimal, field)
+			}
+			if field == 1 {
+				text = data[n : n+int(l)] //nolint:gosec
+				found = true
+			}
+			n += int(l) //nolint:gosec
+		case 5: // 32-bit
+			n = 4
+		default:
+			return Decimal{}, fmt.Errorf("%w: wire type %v is not supported", errInvalidDecimal, typ)
+		}
+		if n > len(data) {
+			return Decimal{}, fmt.Errorf("%w: truncated field %v", errInvalidDecimal, field)
+		}
+		data = data[n:]
+	}
+	if !found {
+		return Decimal{}, fmt.Errorf("%w: missing value field", errInvalidDecimal)
+	}
+	return parse(text)
+}
+
+// parseProtoVarint parses a protobuf base 128 varint.
+// It returns the value and the number of bytes read, or 0 if the varint is malformed.
+func parseProtoVarint(data []byte) (uint64, int) {
+	var u uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		b := data[i]
+		u |= uint64(b&0b0111_1111) << (7 * i)
+		if b < 0b1000_0000 {
+			return u, i + 1
+		}
+	}
+	return 0, 0
+}
+
+// appendProtoVarint appends a protobuf base 128 varint to the slice.
+func appendProtoVarint(data []byte, u uint64) []byte {
+	for u >= 0b1000_0000 {
+		data = append(data, byte(u)|0b1000_0000)
+		u >>= 7
+	}
+	return append(data, byte(u))
+}
+
+// MarshalCBOR implements the [cbor.Marshaler] interface.
+// MarshalCBOR always returns a [decimal fraction], which is a tag 4 followed
+// by the array [exponent, mantissa], where the exponent is the negated scale
+// and the mantissa is the signed coefficient.
+// See also method [Decimal.UnmarshalCBOR].
+//
+// [cbor.Marshaler]: https://pkg.go.dev/github.com/fxamacker/cbor/v2#Marshaler
+// [decimal fraction]: https://datatracker.ietf.org/doc/html/rfc8949#section-3.4.4
+func (d Decimal) MarshalCBOR() ([]byte, error) {
+	data := make([]byte, 0, 12)
+	data = appendCBORHead(data, 6, 4) // tag 4
+	data = appendCBORHead(data, 4, 2) // array of 2 items
+	if d.Scale() == 0 {
+		data = appendCBORHead(data, 0, 0)
+	} else {
+		data = appendCBORHead(data, 1, uint64(d.Scale()-1)) //nolint:gosec
+	}
+	if d.IsNeg() {
+		data = appendCBORHead(data, 1, uint64(d.coef-1))
+	} else {
+		data = appendCBORHead(data, 0, uint64(d.coef))
+	}
+	return data, nil
+}
+
+// UnmarshalCBOR implements the [cbor.Unmarshaler] interface.
+// UnmarshalCBOR supports [decimal fractions] with integer exponents
+// from -330 to 330 and integer mantissas.
+// Bignum mantissas are not supported.
+// See also method [Decimal.MarshalCBOR].
+//
+// [cbor.Unmarshaler]: https://pkg.go.dev/github.com/fxamacker/cbor/v2#Unmarshaler
+// [decimal fractions]: https://datatracker.ietf.org/doc/html/rfc8949#section-3.4.4
+func (d *Decimal) UnmarshalCBOR(data []byte) error {
+	var err error
+	*d, err = parseCBOR(data)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	return nil
+}
+
+// parseCBOR parses a CBOR decimal fraction to a (possibly rounded) decimal.
+func parseCBOR(data []byte) (Decimal, error) {
+	// Tag
+	major, arg, n := parseCBORHead(data)
+	if n == 0 || major != 6 || arg != 4 {
+		return Decimal{}, fmt.Errorf("%w: expected tag 4", errInvalidDecimal)
+	}
+	data = data[n:]
+
+	// Array
+	major, arg, n = parseCBORHead(data)
+	if n == 0 || major != 4 || arg != 2 {
+		return Decimal{}, fmt.Errorf("%w: expected array of 2 items", errInvalidDecimal)
+	}
+	data = data[n:]
+
+	// Exponent
+	emajor, earg, n := parseCBORHead(data)
+	if n == 0 || emajor > 1 {
+		return Decimal{}, fmt.Errorf("%w: expected integer exponent", errInvalidDecimal)
+	}
+	data = data[n:]
+
+	// Mantissa
+	major, arg, n = parseCBORHead(data)
+	if n == 0 || major > 1 {
+		return Decimal{}, fmt.Errorf("%w: expected integer mantissa", errInvalidDecimal)
+	}
+	data = data[n:]
+
+	if len(data) != 0 {
+		return Decimal{}, fmt.Errorf("%w: unexpected trailing data", errInvalidDecimal)
+	}
+
+	// Special case: zero mantissa
+	if major == 0 && arg == 0 {
+		if emajor == 0 {
+			return Decimal{}, nil
+		}
+		return newUnsafe(false, 0, int(min(earg, MaxScale-1))+1), nil //nolint:gosec
+	}
+
+	// General case
+	var exp int
+	switch {
+	case emajor == 0 && earg <= 330:
+		exp = int(earg) //nolint:gosec
+	case emajor == 1 && earg < 330:
+		exp = -1 - int(earg) //nolint:gosec
+	default:
+		return Decimal{}, fmt.Errorf("%w: exponent is out of range", errInvalidDecimal)
+	}
+	bcoef := getBint()
+	defer putBint(bcoef)
+	bcoef.setFint(fint(arg))
+	neg := major == 1
+	if neg {
+		bcoef.inc(bcoef)
+	}
+	return newFromBint(neg, bcoef, -exp, 0)
+}
+
+// parseCBORHead parses the initial byte and the argument of a CBOR data item.
+// It returns the major type, the argument, and the number of bytes read,
+// or 0 if the head is malformed or uses an indefinite length.
+func parseCBORHead(data []byte) (major byte, arg uint64, n int) {
+	if len(data) == 0 {
+		return 0, 0, 0
+	}
+	major, info := data[0]>>5, data[0]&0b0001_1111
+	switch {
+	case info < 24:
+		return major, uint64(info), 1
+	case info > 27:
+		return 0, 0, 0
+	}
+	n = 1 << (info - 24)
+	if len(data) < n+1 {
+		return 0, 0, 0
+	}
+	for i := 1; i <= n; i++ {
+		arg = arg<<8 | uint64(data[i])
+	}
+	return major, arg, n + 1
+}
+
+// appendCBORHead appends the initial byte and the argument of a CBOR data item
+// to the slice, using the shortest possible encoding.
+func appendCBORHead(data []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(data, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(data, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return append(data, major|25, byte(arg>>8), byte(arg))
+	case arg <= math.MaxUint32:
+		return append(data, major|26, byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+	default:
+		return append(data, major|27, byte(arg>>56), byte(arg>>48), byte(arg>>40), byte(arg>>32), byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+	}
+}
+
+// MarshalMsgPack returns the decimal encoded as a [MessagePack] string.
+// See also method [Decimal.String].
+//
+// [MessagePack]: https://github.com/msgpack/msgpack/blob/master/spec.md
+func (d Decimal) MarshalMsgPack() ([]byte, error) {
+	data := make([]byte, 1, 24)
+	data = d.append(data)
+	data[0] = 0b1010_0000 | byte(len(data)-1) // fixstr, at most 22 bytes
+	return data, nil
+}
+
+// UnmarshalMsgPack decodes a [MessagePack] value into the decimal.
+// UnmarshalMsgPack supports the following formats: str, int, uint, and float.
+// See also constructors [Parse], [New], and [NewFromFloat64].
+//
+// [MessagePack]: https://github.com/msgpack/msgpack/blob/master/spec.md
+func (d *Decimal) UnmarshalMsgPack(data []byte) error {
+	var err error
+	*d, err = parseMsgPack(data)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	return nil
+}
+
+// parseMsgPack parses a MessagePack value to a (possibly rounded) decimal.
+// The byte order of the input data must be big-endian.
+func parseMsgPack(data []byte) (Decimal, error) {
+	if len(data) == 0 {
+		return Decimal{}, fmt.Errorf("%w: invalid data length %v", errInvalidDecimal, len(data))
+	}
+	// constants are from https://github.com/msgpack/msgpack/blob/master/spec.md
+	typ, data := data[0], data[1:]
+	switch {
+	case typ <= 0x7f, typ >= 0xe0: // positive fixint, negative fixint
+		if len(data) != 0 {
+			return Decimal{}, fmt.Errorf("%w: invalid data length %v", errInvalidDecimal, len(data)+1)
+		}
+		return New(int64(int8(typ)), 0)
+	case typ >= 0xa0 && typ <= 0xbf: // fixstr
+		return parseMsgPackString(data, int(typ&0b0001_1111))
+	}
+	var size int
+	switch typ {
+	case 0xcc, 0xd0, 0xd9: // uint 8, int 8, str 8
+		size = 1
+	case 0xcd, 0xd1, 0xda: // uint 16, int 16, str 16
+		size = 2
+	case 0xce, 0xd2, 0xdb, 0xca: // uint 32, int 32, str 32, float 32
+		size = 4
+	case 0xcf, 0xd3, 0xcb: // uint 64, int 64, float 64
+		size = 8
+	default:
+		return Decimal{}, fmt.Errorf("%w: MessagePack type 0x%02x is not supported", errInvalidDecimal, typ)
+	}
+	if len(data) < size {
+		return Decimal{}, fmt.Errorf("%w: invalid data length %v", errInvalidDecimal, len(data))
+	}
+	var u uint64
+	for i := range size {
+		u = u<<8 | uint64(data[i])
+	}
+	data = data[size:]
+	if typ == 0xd9 || typ == 0xda || typ == 0xdb {
+		return parseMsgPackString(data, int(u)) //nolint:gosec
+	}
+	if len(data) != 0 {
+		return Decimal{}, fmt.Errorf("%w: invalid data length %v", errInvalidDecimal, len(data)+size+1)
+	}
+	switch typ {
+	case 0xca:
+		return NewFromFloat64(float64(math.Float32frombits(uint32(u)))) //nolint:gosec
+	case 0xcb:
+		return NewFromFloat64(math.Float64frombits(u))
+	case 0xd0:
+		return New(int64(int8(u)), 0) //nolint:gosec
+	case 0xd1:
+		return New(int64(int16(u)), 0) //nolint:gosec
+	case 0xd2:
+		return New(int64(int32(u)), 0) //nolint:gosec
+	case 0xd3:
+		return New(int64(u), 0) //nolint:gosec
+	default:
+		return newSafe(false, fint(u), 0)
+	}
+}
+
+// parseMsgPackString parses a MessagePack string payload of length l to a (possibly rounded) decimal.
+func parseMsgPackString(data []byte, l int) (Decimal, error) {
+	if l < 1 || l > 330 || len(data) != l {
+		return Decimal{}, fmt.Errorf("%w: invalid string length %v", errInvalidDecimal, l)
+	}
+	return parse(data)
+}
+
+// MarshalCSV returns the decimal as a numeric string, so that a decimal can
+// be used as a field by CSV libraries such as [gocsv].
+// See also method [Decimal.String].
+//
+// [gocsv]: https://pkg.go.dev/github.com/gocarina/gocsv
+func (d Decimal) MarshalCSV() (string, error) {
+	return d.String(), nil
+}
+
+// UnmarshalCSV parses a CSV field into the decimal, so that a decimal can
+// be used as a field by CSV libraries such as [gocsv].
+// UnmarshalCSV supports only numeric strings.
+// See also constructor [Parse].
+//
+// [gocsv]: https://pkg.go.dev/github.com/gocarina/gocsv
+func (d *Decimal) UnmarshalCSV(s string) error {
+	var err error
+	*d, err = Parse(s)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Decimal{}, err)
+	}
+	return nil
+}
+
 // Scan implements the [sql.Scanner] interface.
 //
 // [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
@@ -926,6 +2009,19 @@ func (d Decimal) Value() (driver.Value, error) {
 	return d.String(), nil
 }
 
+// GoString implements the [fmt.GoStringer] interface and returns
+// a string showing the internal fields of the decimal:
+//
+//	decimal.Decimal{neg:true, scale:2, coef:123}
+//
+// GoString is intended for debugging and test failure messages.
+// See also method [Decimal.String].
+//
+// [fmt.GoStringer]: https://pkg.go.dev/fmt#GoStringer
+func (d Decimal) GoString() string {
+	return fmt.Sprintf("%T{neg:%v, scale:%v, coef:%v}", d, d.neg, d.scale, uint64(d.coef))
+}
+
 // Format implements the [fmt.Formatter] interface.
 // The following [format verbs] are available:
 //
@@ -936,6 +2032,8 @@ func (d Decimal) Value() (driver.Value, error) {
 //	| %k         | 567%    | Percentage     |
 //
 // The following format flags can be used with all verbs: '+', ' ', '0', '-'.
+// The '#' flag is only supported for %v verb, where it prints the internal
+// representation of the decimal, see method [Decimal.GoString].
 //
 // Precision is only supported for %f and %k verbs.
 // For %f verb, the default precision is equal to the actual scale of the decimal,
@@ -948,6 +2046,13 @@ func (d Decimal) Value() (driver.Value, error) {
 func (d Decimal) Format(state fmt.State, verb rune) {
 	var err error
 
+	// Internal representation
+	if verb == 'v' && state.Flag('#') {
+		//nolint:errcheck
+		io.WriteString(state, d.GoString())
+		return
+	}
+
 	// Percentage multiplier
 	if verb == 'k' || verb == 'K' {
 		d, err = d.Mul(Hundred)
@@ -1139,24 +2244,150 @@ func (d Decimal) ULP() Decimal {
 	return newUnsafe(false, 1, d.Scale())
 }
 
+// Pred returns the largest decimal less than d that has the same scale,
+// that is, d - d.ULP():
+//
+//	1.23.Pred() = 1.22
+//	0.0.Pred()  = -0.1
+//
+// This method is useful for turning an inclusive bound into an exclusive one.
+// See also methods [Decimal.Succ], [Decimal.ULP].
+//
+// Pred returns an error if the result cannot be represented with the same scale.
+func (d Decimal) Pred() (Decimal, error) {
+	f, err := d.SubExact(d.ULP(), d.Scale())
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing predecessor of %v: %w", d, err)
+	}
+	return f, nil
+}
+
+// Succ returns the smallest decimal greater than d that has the same scale,
+// that is, d + d.ULP():
+//
+//	 1.23.Succ() = 1.24
+//	-0.1.Succ()  = 0.0
+//
+// This method is useful for enumerating decimals on a tick-size grid.
+// See also methods [Decimal.Pred], [Decimal.ULP].
+//
+// Succ returns an error if the result cannot be represented with the same scale.
+func (d Decimal) Succ() (Decimal, error) {
+	f, err := d.AddExact(d.ULP(), d.Scale())
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing successor of %v: %w", d, err)
+	}
+	return f, nil
+}
+
+// Pointer returns a pointer to a copy of the decimal.
+// It simplifies the initialization of optional struct fields of type *Decimal.
+func (d Decimal) Pointer() *Decimal {
+	return &d
+}
+
 // Prec returns the number of digits in the coefficient.
 // See also method [Decimal.Coef].
 func (d Decimal) Prec() int {
 	return d.coef.prec()
 }
 
+// IsMaxScale returns:
+//
+//	true  if the decimal has [MaxScale] digits after the decimal point
+//	false otherwise
+//
+// Such a decimal cannot be rescaled to a larger scale, so operations that
+// would add more digits after the decimal point have to round.
+// See also methods [Decimal.Scale], [Decimal.ScaleInRange].
+func (d Decimal) IsMaxScale() bool {
+	return d.Scale() == MaxScale
+}
+
+// BitLen returns the number of bits required to represent the absolute value
+// of the coefficient.
+// The result is 0 for a zero coefficient and at most 64.
+// See also methods [Decimal.Coef], [Decimal.Prec].
+func (d Decimal) BitLen() int {
+	return bits.Len64(d.Coef())
+}
+
 // Coef returns the coefficient of the decimal.
 // See also method [Decimal.Prec].
 func (d Decimal) Coef() uint64 {
 	return uint64(d.coef)
 }
 
+// ToScientific returns the components of the decimal in normalized scientific
+// notation, so that d = mantissa * 10^exponent and 1 <= |mantissa| < 10:
+//
+//	12300.456.ToScientific() = (1.2300456, 4)
+//	0.005.ToScientific()     = (5, -3)
+//
+// The mantissa has the same coefficient as the decimal.
+// If the decimal is 0, the result is (0, 0).
+func (d Decimal) ToScientific() (mantissa Decimal, exponent int) {
+	if d.IsZero() {
+		return newUnsafe(false, 0, 0), 0
+	}
+	prec := d.Prec()
+	return newUnsafe(d.IsNeg(), d.coef, prec-1), prec - d.Scale() - 1
+}
+
+// UnscaledValue returns the signed coefficient of the decimal,
+// so that d = UnscaledValue / 10^Scale.
+// See also methods [Decimal.Coef], [Decimal.ToFixed].
+//
+// UnscaledValue returns an error if the coefficient cannot be represented
+// as an int64.
+func (d Decimal) UnscaledValue() (int64, error) {
+	if d.IsNeg() {
+		if d.coef > -math.MinInt64 {
+			return 0, fmt.Errorf("converting %v to unscaled value: %w", d, errDecimalOverflow)
+		}
+		//nolint:gosec
+		return -int64(d.coef), nil
+	}
+	if d.coef > math.MaxInt64 {
+		return 0, fmt.Errorf("converting %v to unscaled value: %w", d, errDecimalOverflow)
+	}
+	//nolint:gosec
+	return int64(d.coef), nil
+}
+
 // Scale returns the number of digits after the decimal point.
 // See also methods [Decimal.Prec], [Decimal.MinScale].
 func (d Decimal) Scale() int {
 	return int(d.scale)
 }
 
+// ScaleInRange returns:
+//
+//	true  if lo ≤ d.Scale() ≤ hi
+//	false otherwise
+//
+// This method is useful for validating that input data follows the decimal
+// places convention of an instrument, such as at most 8 digits after the
+// decimal point for crypto amounts.
+// See also methods [Decimal.Scale], [Decimal.IsRepresentableAs].
+func (d Decimal) ScaleInRange(lo, hi int) bool {
+	return lo <= d.Scale() && d.Scale() <= hi
+}
+
+// Exponent returns the base-10 exponent of the decimal, so that
+// d = coefficient * 10^Exponent.
+// The exponent is always the negated scale:
+//
+//	1.23.Exponent() = -2
+//	123.Exponent()  =  0
+//
+// This method is useful for exchanging decimals with systems that represent
+// them as (coefficient, exponent) pairs.
+// See also methods [Decimal.Coef], [Decimal.Scale].
+func (d Decimal) Exponent() int {
+	return -d.Scale()
+}
+
 // MinScale returns the smallest scale that the decimal can be rescaled to
 // without rounding.
 // See also method [Decimal.Trim].
@@ -1170,6 +2401,24 @@ func (d Decimal) MinScale() int {
 	return max(MinScale, d.Scale()-dcoef.ntz())
 }
 
+// IsRepresentableAs returns:
+//
+//	true  if the decimal can be rescaled to the given scale without rounding
+//	false otherwise
+//
+// This method is useful for validating that a price is a multiple of
+// the instrument's tick precision.
+// See also methods [Decimal.MinScale], [Decimal.Rescale].
+func (d Decimal) IsRepresentableAs(scale int) bool {
+	if scale < MinScale || scale > MaxScale {
+		return false
+	}
+	if d.MinScale() > scale {
+		return false
+	}
+	return d.Prec()-d.Scale()+scale <= MaxPrec
+}
+
 // IsInt returns true if there are no significant digits after the decimal point.
 func (d Decimal) IsInt() bool {
 	return d.Scale() == 0 || d.coef%pow10[d.Scale()] == 0
@@ -1183,6 +2432,16 @@ func (d Decimal) IsOne() bool {
 	return d.coef == pow10[d.Scale()]
 }
 
+// IsUnity returns:
+//
+//	true  if d = 1
+//	false otherwise
+//
+// Unlike [Decimal.IsOne], it returns false for -1.
+func (d Decimal) IsUnity() bool {
+	return d.IsOne() && !d.IsNeg()
+}
+
 // WithinOne returns:
 //
 //	true  if -1 < d < 1
@@ -1191,35 +2450,189 @@ func (d Decimal) WithinOne() bool {
 	return d.coef < pow10[d.Scale()]
 }
 
-// Round returns a decimal rounded to the specified number of digits after
-// the decimal point using [rounding half to even] (banker's rounding).
+// RoundingMode determines how a decimal is rounded when digits are dropped.
+// The zero value is [RoundHalfEven], the mode used by [Decimal.Round].
+type RoundingMode int8
+
+const (
+	RoundHalfEven RoundingMode = iota // RoundHalfEven rounds to nearest, with ties to even (banker's rounding).
+	RoundHalfUp                       // RoundHalfUp rounds to nearest, with ties away from zero.
+	RoundHalfDown                     // RoundHalfDown rounds to nearest, with ties toward zero.
+	RoundUp                           // RoundUp rounds away from zero.
+	RoundDown                         // RoundDown rounds toward zero.
+	RoundCeiling                      // RoundCeiling rounds toward positive infinity.
+	RoundFloor                        // RoundFloor rounds toward negative infinity.
+)
+
+// String implements the [fmt.Stringer] interface.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	case RoundCeiling:
+		return "Ceiling"
+	case RoundFloor:
+		return "Floor"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int8(m))
+}
+
+// roundUp reports whether the magnitude of a truncated quotient
+// must be incremented by one.
+// The neg flag is the sign of the result, odd is the parity of the truncated
+// quotient, inexact indicates a non-zero remainder, and half is the result of
+// comparing the remainder with half of the divisor (-1, 0, or +1).
+// Unknown modes are treated as [RoundHalfEven].
+func (m RoundingMode) roundUp(neg, odd, inexact bool, half int) bool {
+	switch m {
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundUp:
+		return inexact
+	case RoundDown:
+		return false
+	case RoundCeiling:
+		return inexact && !neg
+	case RoundFloor:
+		return inexact && neg
+	}
+	return half > 0 || (half == 0 && odd)
+}
+
+// RoundWithMode returns a decimal rounded to the specified number of digits
+// after the decimal point using the given rounding mode.
 // If the given scale is negative, it is redefined to zero.
 // For financial calculations, the scale should be equal to or greater than
 // the scale of the currency.
-// See also method [Decimal.Rescale].
-//
-// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
-func (d Decimal) Round(scale int) Decimal {
+// See also methods [Decimal.Round], [Decimal.Trunc], [Decimal.Ceil], [Decimal.Floor].
+func (d Decimal) RoundWithMode(scale int, mode RoundingMode) Decimal {
 	scale = max(scale, MinScale)
 	if scale >= d.Scale() {
 		return d
 	}
 	coef := d.coef
-	coef = coef.rshHalfEven(d.Scale() - scale)
+	coef = coef.rshMode(d.Scale()-scale, d.IsNeg(), mode)
 	return newUnsafe(d.IsNeg(), coef, scale)
 }
 
-// Pad returns a decimal zero-padded to the specified number of digits after
-// the decimal point.
-// The total number of digits in the result is limited by [MaxPrec].
-// See also method [Decimal.Trim].
-func (d Decimal) Pad(scale int) Decimal {
-	scale = min(scale, MaxScale, MaxPrec-d.Prec()+d.Scale())
-	if scale <= d.Scale() {
+// RoundAway returns a decimal rounded to the specified number of digits
+// after the decimal point using [rounding away from zero].
+// If the given scale is negative, it is redefined to zero.
+// It is the counterpart of [Decimal.Trunc], which rounds toward zero.
+// See also method [Decimal.RoundWithMode].
+//
+// [rounding away from zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_away_from_zero
+func (d Decimal) RoundAway(scale int) Decimal {
+	scale = max(scale, MinScale)
+	if scale >= d.Scale() {
 		return d
 	}
 	coef := d.coef
-	coef, ok := coef.lsh(scale - d.Scale())
+	coef = coef.rshUp(d.Scale() - scale)
+	return newUnsafe(d.IsNeg(), coef, scale)
+}
+
+// HalfUp returns a decimal rounded to the specified number of digits after
+// the decimal point using [rounding half away from zero].
+// If the given scale is negative, it is redefined to zero.
+// See also methods [Decimal.HalfDown], [Decimal.Round].
+//
+// [rounding half away from zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_away_from_zero
+func (d Decimal) HalfUp(scale int) Decimal {
+	return d.RoundWithMode(scale, RoundHalfUp)
+}
+
+// HalfDown returns a decimal rounded to the specified number of digits after
+// the decimal point using [rounding half toward zero].
+// If the given scale is negative, it is redefined to zero.
+// See also methods [Decimal.HalfUp], [Decimal.Round].
+//
+// [rounding half toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_toward_zero
+func (d Decimal) HalfDown(scale int) Decimal {
+	return d.RoundWithMode(scale, RoundHalfDown)
+}
+
+// Round returns a decimal rounded to the specified number of digits after
+// the decimal point using [rounding half to even] (banker's rounding).
+// If the given scale is negative, it is redefined to zero.
+// For financial calculations, the scale should be equal to or greater than
+// the scale of the currency.
+// See also method [Decimal.Rescale].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) Round(scale int) Decimal {
+	scale = max(scale, MinScale)
+	if scale >= d.Scale() {
+		return d
+	}
+	coef := d.coef
+	coef = coef.rshHalfEven(d.Scale() - scale)
+	return newUnsafe(d.IsNeg(), coef, scale)
+}
+
+// RoundSignificant returns a decimal rounded to n significant digits using
+// the specified rounding mode.
+// If n is less than one, it is redefined to one.
+// Digits of the integer part are replaced with zeros if necessary:
+//
+//	123.456 rounded to 4 significant digits is 123.5
+//	123.456 rounded to 2 significant digits is 120
+//
+// See also methods [Decimal.RoundSignificantFigures], [Decimal.RoundWithMode].
+//
+// RoundSignificant returns an error if the integer part of the result has
+// more than [MaxPrec] digits.
+func (d Decimal) RoundSignificant(n int, mode RoundingMode) (Decimal, error) {
+	n = max(n, 1)
+	shift := d.Prec() - n
+	if shift <= d.Scale() {
+		return d.RoundWithMode(d.Scale()-shift, mode), nil
+	}
+	coef := d.coef
+	coef = coef.rshMode(shift, d.IsNeg(), mode)
+	coef, ok := coef.lsh(shift - d.Scale())
+	if !ok {
+		return Decimal{}, fmt.Errorf("rounding %v to %v significant digits: %w", d, n, errDecimalOverflow)
+	}
+	return newUnsafe(d.IsNeg(), coef, 0), nil
+}
+
+// RoundSignificantFigures returns a decimal rounded to n significant digits
+// using [rounding half to even] (banker's rounding).
+// If n is less than one, it is redefined to one.
+// See also method [Decimal.RoundSignificant].
+//
+// RoundSignificantFigures returns an error if the integer part of the result
+// has more than [MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) RoundSignificantFigures(n int) (Decimal, error) {
+	return d.RoundSignificant(n, RoundHalfEven)
+}
+
+// Pad returns a decimal zero-padded to the specified number of digits after
+// the decimal point.
+// The total number of digits in the result is limited by [MaxPrec].
+// See also method [Decimal.Trim].
+func (d Decimal) Pad(scale int) Decimal {
+	scale = min(scale, MaxScale, MaxPrec-d.Prec()+d.Scale())
+	if scale <= d.Scale() {
+		return d
+	}
+	coef := d.coef
+	coef, ok := coef.lsh(scale - d.Scale())
 	if !ok {
 		return d // Should never happen
 	}
@@ -1269,6 +2682,96 @@ func (d Decimal) Trunc(scale int) Decimal {
 	return newUnsafe(d.IsNeg(), coef, scale)
 }
 
+// Coerce returns a decimal with exactly the specified number of digits after
+// the decimal point.
+// The decimal is truncated using [rounding toward zero] if it has more digits,
+// and zero-padded if it has fewer digits.
+// This method is useful for normalizing decimals of mixed scales to
+// a uniform scale.
+// See also methods [Decimal.Trunc], [Decimal.Pad], [Decimal.Rescale].
+//
+// Coerce returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the decimal has more than [MaxPrec] - scale digits.
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (d Decimal) Coerce(scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("coercing %v to scale %v: %w", d, scale, errScaleRange)
+	}
+	if scale <= d.Scale() {
+		return d.Trunc(scale), nil
+	}
+	f := d.Pad(scale)
+	if f.Scale() != scale {
+		return Decimal{}, fmt.Errorf("coercing %v to scale %v: %w", d, scale, overflowError(d.Prec(), d.Scale(), scale))
+	}
+	return f, nil
+}
+
+// SetScale returns a decimal with exactly the specified number of digits
+// after the decimal point and the same value as d.
+// Unlike [Decimal.Coerce], it never truncates significant digits:
+// the decimal is zero-padded if it has fewer digits, and only trailing zeros
+// are removed if it has more digits.
+// See also methods [Decimal.Pad], [Decimal.Trim], [Decimal.IsRepresentableAs].
+//
+// SetScale returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - non-zero digits would be removed, in which case the error wraps [ErrPrecisionLoss];
+//   - the integer part of the decimal has more than [MaxPrec] - scale digits.
+func (d Decimal) SetScale(scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("setting scale of %v to %v: %w", d, scale, errScaleRange)
+	}
+	if scale <= d.Scale() {
+		if d.MinScale() > scale {
+			return Decimal{}, fmt.Errorf("setting scale of %v to %v: %w", d, scale, ErrPrecisionLoss)
+		}
+		return d.Trunc(scale), nil
+	}
+	f := d.Pad(scale)
+	if f.Scale() != scale {
+		return Decimal{}, fmt.Errorf("setting scale of %v to %v: %w", d, scale, overflowError(d.Prec(), d.Scale(), scale))
+	}
+	return f, nil
+}
+
+// TruncateTo returns a decimal truncated to the specified number of
+// decimal places using [rounding toward zero].
+// Unlike [Decimal.Trunc], a negative number of places truncates digits
+// to the left of the decimal point:
+//
+//	1234.5.TruncateTo(1)  = 1234.5
+//	1234.5.TruncateTo(0)  = 1234
+//	1234.5.TruncateTo(-2) = 1200
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (d Decimal) TruncateTo(places int) Decimal {
+	if places >= MinScale {
+		return d.Trunc(places)
+	}
+	coef := d.coef
+	coef = coef.rshDown(d.Scale() - places)
+	coef, ok := coef.lsh(-places)
+	if !ok {
+		return newUnsafe(false, 0, 0) // Truncated coefficient is always 0 here
+	}
+	return newUnsafe(d.IsNeg(), coef, 0)
+}
+
+// DropIntegerPart returns the fractional part of the decimal.
+// The result has the same sign and scale as the decimal:
+//
+//	 123.456.DropIntegerPart() =  0.456
+//	-1.5.DropIntegerPart()     = -0.5
+//
+// See also method [Decimal.Trunc].
+func (d Decimal) DropIntegerPart() Decimal {
+	coef := d.coef % pow10[d.Scale()]
+	return newUnsafe(d.IsNeg(), coef, d.Scale())
+}
+
 // Trim returns a decimal with trailing zeros removed up to the given number of
 // digits after the decimal point.
 // If the given scale is negative, it is redefined to zero.
@@ -1325,6 +2828,175 @@ func (d Decimal) Floor(scale int) Decimal {
 	return newUnsafe(d.IsNeg(), coef, scale)
 }
 
+// FloorToMultiple returns the largest multiple of the tick that is less than
+// or equal to the decimal.
+// The sign of the tick is ignored, and the result has the same scale as the tick.
+// This method is useful for rounding order quantities down to a lot size.
+// See also method [Decimal.CeilToMultiple].
+//
+// FloorToMultiple returns an error if:
+//   - the tick is 0;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) FloorToMultiple(tick Decimal) (Decimal, error) {
+	tick = tick.Abs()
+	q, r, err := d.QuoRem(tick)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("flooring %v to a multiple of %v: %w", d, tick, err)
+	}
+	if r.IsNeg() {
+		q, err = q.Sub(One)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("flooring %v to a multiple of %v: %w", d, tick, err)
+		}
+	}
+	f, err := q.MulExact(tick, tick.Scale())
+	if err != nil {
+		return Decimal{}, fmt.Errorf("flooring %v to a multiple of %v: %w", d, tick, err)
+	}
+	return f, nil
+}
+
+// Bucket returns the lower bound of the bucket of the given size
+// that the decimal falls into, that is floor(d / size) * size.
+// The result has the same scale as the size.
+// This method is useful for building histograms and determining fee tiers.
+// See also method [Decimal.FloorToMultiple].
+//
+// Bucket returns an error if:
+//   - the size is not positive;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Bucket(size Decimal) (Decimal, error) {
+	if !size.IsPos() {
+		return Decimal{}, fmt.Errorf("bucketing %v by %v: %w", d, size, errInvalidOperation)
+	}
+	b, err := d.FloorToMultiple(size)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("bucketing %v by %v: %w", d, size, err)
+	}
+	return b, nil
+}
+
+// CeilToMultiple returns the smallest multiple of the tick that is greater than
+// or equal to the decimal.
+// The sign of the tick is ignored, and the result has the same scale as the tick.
+// This method is useful for rounding order quantities up to a lot size.
+// See also method [Decimal.FloorToMultiple].
+//
+// CeilToMultiple returns an error if:
+//   - the tick is 0;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) CeilToMultiple(tick Decimal) (Decimal, error) {
+	tick = tick.Abs()
+	q, r, err := d.QuoRem(tick)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("ceiling %v to a multiple of %v: %w", d, tick, err)
+	}
+	if r.IsPos() {
+		q, err = q.Add(One)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("ceiling %v to a multiple of %v: %w", d, tick, err)
+		}
+	}
+	f, err := q.MulExact(tick, tick.Scale())
+	if err != nil {
+		return Decimal{}, fmt.Errorf("ceiling %v to a multiple of %v: %w", d, tick, err)
+	}
+	return f, nil
+}
+
+// Snap returns the multiple of the tick that is nearest to the decimal
+// according to the given rounding mode.
+// The sign of the tick is ignored, and the result has the same scale as the tick:
+//
+//	1.234.Snap(0.05, RoundHalfEven) = 1.25
+//	1.234.Snap(0.05, RoundFloor)    = 1.20
+//	-1.25.Snap(0.5, RoundHalfEven)  = -1.0
+//
+// This method is useful for snapping order prices to the instrument's tick size.
+// See also methods [Decimal.FloorToMultiple], [Decimal.CeilToMultiple].
+//
+// Snap returns an error if:
+//   - the tick is 0;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Snap(tick Decimal, mode RoundingMode) (Decimal, error) {
+	tick = tick.Abs()
+	q, r, err := d.QuoRem(tick)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("snapping %v to a multiple of %v: %w", d, tick, err)
+	}
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+
+	tcoef := getBint()
+	defer putBint(tcoef)
+
+	// Compare 2 * |r| with tick
+	rcoef.setFint(r.coef)
+	rcoef.dbl(rcoef)
+	tcoef.setFint(tick.coef)
+	tcoef.lsh(tcoef, r.Scale()-tick.Scale())
+	half := rcoef.cmp(tcoef)
+
+	if mode.roundUp(d.IsNeg(), q.coef%2 != 0, !r.IsZero(), half) {
+		if d.IsNeg() {
+			q, err = q.Sub(One)
+		} else {
+			q, err = q.Add(One)
+		}
+		if err != nil {
+			return Decimal{}, fmt.Errorf("snapping %v to a multiple of %v: %w", d, tick, err)
+		}
+	}
+	f, err := q.MulExact(tick, tick.Scale())
+	if err != nil {
+		return Decimal{}, fmt.Errorf("snapping %v to a multiple of %v: %w", d, tick, err)
+	}
+	return f, nil
+}
+
+// IsMultipleOf returns:
+//
+//	true  if d is an integer multiple of e
+//	false otherwise
+//
+// The check is exact and does not depend on the size of the quotient.
+// This method is useful for validating that a price is a multiple of
+// the instrument's tick size.
+// See also methods [Decimal.FloorToMultiple], [Decimal.IsRepresentableAs].
+//
+// IsMultipleOf returns an error if e is 0.
+func (d Decimal) IsMultipleOf(e Decimal) (bool, error) {
+	if e.IsZero() {
+		return false, fmt.Errorf("checking if %v is a multiple of %v: %w", d, e, errDivisionByZero)
+	}
+
+	dcoef := getBint()
+	defer putBint(dcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+
+	dcoef.setFint(d.coef)
+	ecoef.setFint(e.coef)
+
+	// Alignment
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+	case d.Scale() < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+	}
+
+	// Compute r = d mod e
+	dcoef.quoRem(dcoef, ecoef, rcoef)
+
+	return rcoef.sign() == 0, nil
+}
+
 // Neg returns a decimal with the opposite sign.
 func (d Decimal) Neg() Decimal {
 	return newUnsafe(!d.IsNeg(), d.coef, d.Scale())
@@ -1335,6 +3007,15 @@ func (d Decimal) Abs() Decimal {
 	return newUnsafe(false, d.coef, d.Scale())
 }
 
+// WithSign returns a decimal with the same absolute value as d
+// and the sign specified by neg.
+// WithSign treats 0 as positive, so setting the negative sign on zero
+// returns zero.
+// See also methods [Decimal.CopySign], [Decimal.Neg].
+func (d Decimal) WithSign(neg bool) Decimal {
+	return newUnsafe(neg, d.coef, d.Scale())
+}
+
 // CopySign returns a decimal with the same sign as decimal e.
 // CopySign treates 0 as positive.
 // See also method [Decimal.Sign].
@@ -1386,6 +3067,20 @@ func (d Decimal) IsZero() bool {
 	return d.coef == 0
 }
 
+// IsNaN always returns false, because a decimal is always a finite number.
+// IsNaN is provided for compatibility with interfaces of other numeric types.
+// See also method [Decimal.IsInf].
+func (d Decimal) IsNaN() bool {
+	return false
+}
+
+// IsInf always returns false, because a decimal is always a finite number.
+// IsInf is provided for compatibility with interfaces of other numeric types.
+// See also method [Decimal.IsNaN].
+func (d Decimal) IsInf() bool {
+	return false
+}
+
 // Prod returns the (possibly rounded) product of decimals.
 // It computes d1 * d2 * ... * dn with at least double precision
 // during the intermediate rounding.
@@ -1622,6 +3317,23 @@ func (d Decimal) Mul(e Decimal) (Decimal, error) {
 	return d.MulExact(e, 0)
 }
 
+// MulInt returns the (possibly rounded) product of decimal d and integer n.
+// It is a shorthand for multiplying by a decimal with a scale of 0:
+//
+//	fee, err := feePerUnit.MulInt(units)
+//
+// See also method [Decimal.Mul].
+//
+// MulInt returns an overflow error if the integer part of the result has
+// more than [MaxPrec] digits.
+func (d Decimal) MulInt(n int) (Decimal, error) {
+	e, err := New(int64(n), 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, n, err)
+	}
+	return d.Mul(e)
+}
+
 // MulExact is similar to [Decimal.Mul], but it allows you to specify the number
 // of digits after the decimal point that should be considered significant.
 // If any of the significant digits are lost during rounding, the method will
@@ -1684,6 +3396,88 @@ func (d Decimal) mulBint(e Decimal, minScale int) (Decimal, error) {
 	return newFromBint(dneg, dcoef, dscale, minScale)
 }
 
+// MulRound returns the product of decimals d and e rounded to the specified
+// number of digits after the decimal point using the given rounding mode.
+// Unlike [Decimal.Mul], the result always has exactly the given scale,
+// and it is rounded only once.
+// This method is useful for computing tax amounts, which are often required
+// to be rounded half up.
+// See also method [Decimal.MulExact].
+//
+// MulRound returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the result has more than ([MaxPrec] - scale) digits.
+func (d Decimal) MulRound(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, e, errScaleRange)
+	}
+
+	// General case
+	f, err := d.mulRoundFint(e, scale, mode)
+	if err != nil {
+		f, err = d.mulRoundBint(e, scale, mode)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, e, err)
+		}
+	}
+
+	return f, nil
+}
+
+// mulRoundFint computes the product of two decimals rounded to the given
+// scale using uint64 arithmetic.
+func (d Decimal) mulRoundFint(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	dcoef := d.coef
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := e.coef
+
+	// Compute d = d * e
+	dcoef, ok := dcoef.mul(ecoef)
+	if !ok {
+		return Decimal{}, errDecimalOverflow
+	}
+	dscale = dscale + e.Scale()
+	dneg = dneg != e.IsNeg()
+
+	// Rounding
+	if dscale > scale {
+		dcoef = dcoef.rshMode(dscale-scale, dneg, mode)
+		dscale = scale
+	}
+
+	return newFromFint(dneg, dcoef, dscale, scale)
+}
+
+// mulRoundBint computes the product of two decimals rounded to the given
+// scale using *big.Int arithmetic.
+func (d Decimal) mulRoundBint(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	dcoef.setFint(d.coef)
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+	ecoef.setFint(e.coef)
+
+	// Compute d = d * e
+	dcoef.mul(dcoef, ecoef)
+	dneg = dneg != e.IsNeg()
+	dscale = dscale + e.Scale()
+
+	// Rounding
+	if dscale > scale {
+		dcoef.rshMode(dcoef, dscale-scale, dneg, mode)
+		dscale = scale
+	}
+
+	return newFromBint(dneg, dcoef, dscale, scale)
+}
+
 // Pow returns the (possibly rounded) decimal raised to the given decimal power.
 // If zero is raised to zero power then the result is one.
 //
@@ -1952,18 +3746,240 @@ func (d Decimal) powIntBint(pow uint64, inv bool) (Decimal, error) {
 	return newFromBint(eneg, ecoef, escale, 0)
 }
 
-// Sqrt computes the (possibly rounded) square root of a decimal.
-// d.Sqrt() is significantly faster than d.Pow(0.5).
+// PowFrac returns the (possibly rounded) decimal raised to the rational
+// power num/den, computed as the den-th root of d^num.
+// The fraction num/den is reduced to lowest terms first, so that
+// a negative decimal can be raised to a power with an odd denominator:
 //
-// Sqrt returns an error if the decimal is negative.
-func (d Decimal) Sqrt() (Decimal, error) {
-	// Special case: negative
-	if d.IsNeg() {
-		return Decimal{}, fmt.Errorf("computing sqrt(%v): %w: square root of negative", d, errInvalidOperation)
-	}
-
-	// Special case: zero
-	if d.IsZero() {
+//	(-8)^(2/3) = 4
+//	(-8)^(1/3) = -2
+//
+// If zero is raised to zero power then the result is one.
+// See also methods [Decimal.Pow], [Decimal.PowInt], [Decimal.Sqrt].
+//
+// PowFrac returns an error if:
+//   - den is zero;
+//   - the integer part of the result has more than [MaxPrec] digits;
+//   - zero is raised to a negative power;
+//   - a negative decimal is raised to a power with an even denominator.
+func (d Decimal) PowFrac(num, den int32) (Decimal, error) {
+	// Special case: zero denominator
+	if den == 0 {
+		return Decimal{}, fmt.Errorf("computing [%v^(%v/%v)]: %w: zero denominator", d, num, den, errInvalidOperation)
+	}
+
+	// Reduction to lowest terms
+	n, m := int64(num), int64(den)
+	if m < 0 {
+		n, m = -n, -m
+	}
+	a, b := max(n, -n), m
+	for b != 0 {
+		a, b = b, a%b
+	}
+	n, m = n/a, m/a
+
+	// Special case: integer power
+	if m == 1 {
+		return d.PowInt(int(n))
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		if n < 0 {
+			return Decimal{}, fmt.Errorf("computing [%v^(%v/%v)]: %w: zero to negative power", d, num, den, errInvalidOperation)
+		}
+		return newSafe(false, 0, 0)
+	}
+
+	// Special case: negative to a power with an even denominator
+	if d.IsNeg() && m%2 == 0 {
+		return Decimal{}, fmt.Errorf("computing [%v^(%v/%v)]: %w: even root of negative", d, num, den, errInvalidOperation)
+	}
+
+	// Special case: square root
+	if n == 1 && m == 2 {
+		return d.Sqrt()
+	}
+
+	// General case
+	e, err := d.powFracBint(n, m)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v^(%v/%v)]: %w", d, num, den, err)
+	}
+
+	return e, nil
+}
+
+// powFracBint computes the rational power of a decimal using *big.Int arithmetic.
+// The fraction num/den must be in lowest terms, and den must be greater than one.
+func (d Decimal) powFracBint(num, den int64) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	dcoef.setFint(d.coef)
+	neg := d.IsNeg() && num%2 != 0
+	inv := false
+
+	// Alignment
+	if d.WithinOne() {
+		// Compute d = ⌊1 / d⌋
+		dcoef.quo(bpow10[bscale+d.Scale()], dcoef)
+		inv = true
+	} else {
+		dcoef.lsh(dcoef, bscale-d.Scale())
+	}
+
+	// The initial guess is calculated using float64 arithmetic.
+	f, _ := d.Abs().Float64()
+	if inv {
+		f = 1 / f
+	}
+	f = math.Pow(f, 1/float64(den))
+	ecoef.setFint(fint(f * 1e9))
+	ecoef.lsh(ecoef, bscale-9)
+
+	// Newton's method for e^den = d.
+	// After the first step, the approximations decrease monotonically.
+	for i := range 100 {
+		// Compute f = ⌊((den - 1) * e + d / e^(den - 1)) / den⌋
+		if !fcoef.powInt(ecoef, uint64(den-1)) { //nolint:gosec
+			return Decimal{}, unknownOverflowError()
+		}
+		gcoef.lsh(dcoef, bscale)
+		fcoef.quo(gcoef, fcoef)
+		gcoef.setInt64(den - 1)
+		gcoef.mul(gcoef, ecoef)
+		fcoef.add(fcoef, gcoef)
+		gcoef.setInt64(den)
+		fcoef.quo(fcoef, gcoef)
+		if i > 0 && fcoef.cmp(ecoef) >= 0 {
+			break
+		}
+		ecoef.setBint(fcoef)
+	}
+
+	// Compute f = e^num
+	if num < 0 {
+		num = -num
+		inv = !inv
+	}
+	if !fcoef.powInt(ecoef, uint64(num)) {
+		if !inv {
+			return Decimal{}, unknownOverflowError()
+		}
+		return newSafe(false, 0, MaxScale)
+	}
+
+	if inv {
+		// Compute f = ⌊1 / f⌋
+		fcoef.quo(bpow10[2*bscale], fcoef)
+	}
+
+	return newFromBint(neg, fcoef, bscale, 0)
+}
+
+// powInt calculates z = x^pow using exponentiation by squaring.
+// The argument x must satisfy x >= 1, otherwise the result is undefined.
+// x must be represented as a big integer: round(x * 10^41).
+// The result z is represented as a big integer: ⌊z * 10^41⌋.
+// powInt returns false if z >= 10^59, in which case z is not modified.
+func (z *bint) powInt(x *bint, pow uint64) bool {
+	xcoef := getBint()
+	defer putBint(xcoef)
+
+	zcoef := getBint()
+	defer putBint(zcoef)
+
+	xcoef.setBint(x)
+	zcoef.setBint(bpow10[bscale])
+
+	for pow > 0 {
+		if pow%2 == 1 {
+			zcoef.mul(zcoef, xcoef)
+			zcoef.rshDown(zcoef, bscale)
+			if zcoef.hasPrec(len(bpow10)) {
+				return false
+			}
+		}
+		pow = pow / 2
+		if pow > 0 {
+			xcoef.mul(xcoef, xcoef)
+			xcoef.rshDown(xcoef, bscale)
+			if xcoef.hasPrec(len(bpow10)) {
+				return false
+			}
+		}
+	}
+
+	z.setBint(zcoef)
+	return true
+}
+
+// PowN returns the (possibly rounded) decimal raised to the power n, computed
+// by [exponentiation by squaring].
+// If the absolute value of an intermediate power d^k, 0 <= k <= n, is greater
+// than limit, PowN stops and returns d^k and false.
+// Otherwise, it returns d^n and true.
+// Unlike [Decimal.PowInt], PowN rounds every intermediate product.
+//
+// If an intermediate product overflows, PowN returns the computed power d^k
+// with the largest k and false.
+//
+// [exponentiation by squaring]: https://en.wikipedia.org/wiki/Exponentiation_by_squaring
+func (d Decimal) PowN(n uint, limit Decimal) (Decimal, bool) {
+	p := One
+	if p.Cmp(limit) > 0 {
+		return p, false
+	}
+	b := d
+	for {
+		if n%2 == 1 {
+			q, err := p.Mul(b)
+			if err != nil {
+				return b, false
+			}
+			p = q
+			if p.CmpAbs(limit) > 0 {
+				return p, false
+			}
+		}
+		n = n / 2
+		if n == 0 {
+			return p, true
+		}
+		q, err := b.Mul(b)
+		if err != nil {
+			return b, false
+		}
+		b = q
+		if b.CmpAbs(limit) > 0 {
+			return b, false
+		}
+	}
+}
+
+// Sqrt computes the (possibly rounded) square root of a decimal.
+// d.Sqrt() is significantly faster than d.Pow(0.5).
+//
+// Sqrt returns an error if the decimal is negative.
+func (d Decimal) Sqrt() (Decimal, error) {
+	// Special case: negative
+	if d.IsNeg() {
+		return Decimal{}, fmt.Errorf("computing sqrt(%v): %w: square root of negative", d, errInvalidOperation)
+	}
+
+	// Special case: zero
+	if d.IsZero() {
 		return newSafe(false, 0, d.Scale()/2)
 	}
 
@@ -1984,35 +4000,80 @@ func (d Decimal) sqrtBint() (Decimal, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 
+	dcoef.setFint(d.coef)
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*bscale-d.Scale())
+
+	// Compute d = ⌊√d⌋
+	dcoef.sqrt(dcoef)
+
+	return newFromBint(false, dcoef, bscale, 0)
+}
+
+// Hypot returns the (possibly rounded) Euclidean norm of decimals d and e,
+// that is, √(d² + e²).
+// The sum of squares is computed exactly, so the result is rounded only once.
+// See also method [Decimal.Sqrt].
+//
+// Hypot returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Hypot(e Decimal) (Decimal, error) {
+	// Special case: zeros
+	if d.IsZero() && e.IsZero() {
+		return newSafe(false, 0, max(d.Scale(), e.Scale()))
+	}
+
+	// General case
+	f, err := d.hypotBint(e)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing hypot(%v, %v): %w", d, e, err)
+	}
+
+	// Preferred scale
+	f = f.Trim(max(d.Scale(), e.Scale()))
+
+	return f, nil
+}
+
+// hypotBint computes the Euclidean norm of two decimals using *big.Int arithmetic.
+func (d Decimal) hypotBint(e Decimal) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+
 	ecoef := getBint()
 	defer putBint(ecoef)
 
-	fcoef := getBint()
-	defer putBint(fcoef)
-
 	dcoef.setFint(d.coef)
-	fcoef.setFint(0)
+	ecoef.setFint(e.coef)
+
+	// Compute d = d * d, e = e * e
+	dcoef.mul(dcoef, dcoef)
+	ecoef.mul(ecoef, ecoef)
 
 	// Alignment
-	dcoef.lsh(dcoef, 2*bscale-d.Scale())
+	dcoef.lsh(dcoef, 2*bscale-2*d.Scale())
+	ecoef.lsh(ecoef, 2*bscale-2*e.Scale())
 
-	// Initial guess is calculated as 10^(n/2),
-	// where n is the position of the most significant digit.
-	n := dcoef.prec() - 2*bscale
-	ecoef.setBint(bpow10[n/2+bscale])
+	// Compute d = ⌊√(d + e)⌋
+	dcoef.add(dcoef, ecoef)
+	dcoef.sqrt(dcoef)
 
-	// Newton's method
-	for range 50 {
-		if ecoef.cmp(fcoef) == 0 {
-			break
-		}
-		fcoef.setBint(ecoef)
-		ecoef.quo(dcoef, ecoef)
-		ecoef.add(ecoef, fcoef)
-		ecoef.hlf(ecoef)
-	}
+	return newFromBint(false, dcoef, bscale, 0)
+}
 
-	return newFromBint(false, ecoef, bscale, 0)
+// ApproxSqrt returns the square root of a decimal computed using float64
+// arithmetic.
+// ApproxSqrt is significantly faster than [Decimal.Sqrt], but the result is
+// only an approximation.
+// See also method [Decimal.Float64].
+//
+// ApproxSqrt returns an error if the decimal is negative.
+func (d Decimal) ApproxSqrt() (float64, error) {
+	if d.IsNeg() {
+		return 0, fmt.Errorf("computing sqrt(%v): %w: square root of negative", d, errInvalidOperation)
+	}
+	f, _ := d.Float64()
+	return math.Sqrt(f), nil
 }
 
 // Log2 returns the (possibly rounded) binary logarithm of a decimal.
@@ -2280,6 +4341,21 @@ func (z *bint) log(x *bint) {
 	z.setBint(zcoef)
 }
 
+// ApproxLog returns the natural logarithm of a decimal computed using float64
+// arithmetic.
+// ApproxLog is significantly faster than [Decimal.Log], but the result is
+// only an approximation.
+// See also method [Decimal.Float64].
+//
+// ApproxLog returns an error if the decimal is zero or negative.
+func (d Decimal) ApproxLog() (float64, error) {
+	if !d.IsPos() {
+		return 0, fmt.Errorf("computing log(%v): %w: logarithm of non-positive", d, errInvalidOperation)
+	}
+	f, _ := d.Float64()
+	return math.Log(f), nil
+}
+
 // Exp returns the (possibly rounded) exponential of a decimal.
 //
 // Exp returns an error if the integer part of the result has more than [MaxPrec] digits.
@@ -2333,6 +4409,16 @@ func (d Decimal) expBint() (Decimal, error) {
 	return newFromBint(false, ecoef, bscale, 0)
 }
 
+// ApproxExp returns the exponential of a decimal computed using float64
+// arithmetic.
+// ApproxExp is significantly faster than [Decimal.Exp], but the result is
+// only an approximation, and it overflows to +Inf for large decimals.
+// See also method [Decimal.Float64].
+func (d Decimal) ApproxExp() float64 {
+	f, _ := d.Float64()
+	return math.Exp(f)
+}
+
 // Expm1 returns the (possibly rounded) shifted exponential of a decimal.
 //
 // Expm1 returns an error if the integer part of the result has more than [MaxPrec] digits.
@@ -2443,6 +4529,385 @@ func (z *bint) exp(x *bint) {
 	z.rshDown(z, bscale)
 }
 
+// Sin returns the (possibly rounded) sine of a decimal, where the decimal
+// is an angle in radians.
+// See also methods [Decimal.Cos], [Decimal.Tan].
+func (d Decimal) Sin() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	sneg, _ := d.sinCos(scoef, ccoef)
+	e, err := newFromBint(sneg, scoef, bscale, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing sin(%v): %w", d, err)
+	}
+
+	return e, nil
+}
+
+// Cos returns the (possibly rounded) cosine of a decimal, where the decimal
+// is an angle in radians.
+// See also methods [Decimal.Sin], [Decimal.Tan].
+func (d Decimal) Cos() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 1, 0)
+	}
+
+	// General case
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	_, cneg := d.sinCos(scoef, ccoef)
+	e, err := newFromBint(cneg, ccoef, bscale, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing cos(%v): %w", d, err)
+	}
+
+	return e, nil
+}
+
+// Tan returns the (possibly rounded) tangent of a decimal, where the decimal
+// is an angle in radians.
+// See also methods [Decimal.Sin], [Decimal.Cos].
+//
+// Tan returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Tan() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	sneg, cneg := d.sinCos(scoef, ccoef)
+	if ccoef.sign() == 0 {
+		return Decimal{}, fmt.Errorf("computing tan(%v): %w", d, unknownOverflowError())
+	}
+
+	// Compute s = ⌊s / c⌋
+	scoef.lsh(scoef, bscale)
+	scoef.quo(scoef, ccoef)
+
+	e, err := newFromBint(sneg != cneg, scoef, bscale, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing tan(%v): %w", d, err)
+	}
+
+	return e, nil
+}
+
+// sinCos calculates the sine and cosine of a decimal using *big.Int arithmetic.
+// The absolute values of the results are represented as big integers:
+// s = |sin(d)| * 10^41 and c = |cos(d)| * 10^41.
+// sinCos returns the signs of the results.
+func (d Decimal) sinCos(s, c *bint) (sneg, cneg bool) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+
+	xcoef.setFint(d.coef)
+
+	// Alignment
+	xcoef.lsh(xcoef, 2*bscale-d.Scale())
+
+	// Compute k = ⌊|d| / (π / 2)⌋, r = |d| - k * π / 2
+	xcoef.quoRem(xcoef, bhpi, rcoef)
+	rcoef.rshDown(rcoef, bscale)
+
+	// Compute q = k mod 4, k always fits in uint64
+	q := xcoef.fint() % 4
+
+	// Compute s = sin(r), c = cos(r)
+	xcoef.sin(rcoef)
+	rcoef.cos(rcoef)
+
+	// Quadrant adjustment
+	switch q {
+	case 0:
+		s.setBint(xcoef)
+		c.setBint(rcoef)
+	case 1: // sin(r + π/2) = cos(r), cos(r + π/2) = -sin(r)
+		s.setBint(rcoef)
+		c.setBint(xcoef)
+		cneg = true
+	case 2: // sin(r + π) = -sin(r), cos(r + π) = -cos(r)
+		s.setBint(xcoef)
+		c.setBint(rcoef)
+		sneg, cneg = true, true
+	case 3: // sin(r + 3π/2) = -cos(r), cos(r + 3π/2) = sin(r)
+		s.setBint(rcoef)
+		c.setBint(xcoef)
+		sneg = true
+	}
+
+	// Sine is an odd function, cosine is an even function
+	sneg = sneg != d.IsNeg()
+
+	return sneg, cneg
+}
+
+// sin calculates z = sin(x) using Taylor series expansion.
+// The argument x must satisfy 0 <= x <= π/2, otherwise the result is undefined.
+// The argument x must be represented as a big integer: round(x * 10^41).
+// The result z is represented as a big integer: round(z * 10^41).
+func (z *bint) sin(x *bint) {
+	zcoef := getBint()
+	defer putBint(zcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	// Compute h = x^2
+	hcoef.mul(x, x)
+	hcoef.rshDown(hcoef, bscale)
+
+	zcoef.setBint(x)
+	gcoef.setBint(x)
+
+	// Compute sin(x) using Taylor series expansion
+	// sin(x) = x^1 / 1! - x^3 / 3! + ... + (-1)^n * x^(2n+1) / (2n+1)!
+	for n := int64(1); ; n++ {
+		gcoef.mul(gcoef, hcoef)
+		gcoef.rshDown(gcoef, bscale)
+		ncoef.setInt64((2 * n) * (2*n + 1))
+		gcoef.quo(gcoef, ncoef)
+		if gcoef.sign() == 0 {
+			break
+		}
+		if n%2 == 1 {
+			zcoef.sub(zcoef, gcoef)
+		} else {
+			zcoef.add(zcoef, gcoef)
+		}
+	}
+
+	z.setBint(zcoef)
+}
+
+// cos calculates z = cos(x) using Taylor series expansion.
+// The argument x must satisfy 0 <= x <= π/2, otherwise the result is undefined.
+// The argument x must be represented as a big integer: round(x * 10^41).
+// The result z is represented as a big integer: round(z * 10^41).
+func (z *bint) cos(x *bint) {
+	zcoef := getBint()
+	defer putBint(zcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	// Compute h = x^2
+	hcoef.mul(x, x)
+	hcoef.rshDown(hcoef, bscale)
+
+	zcoef.setBint(bpow10[bscale])
+	gcoef.setBint(bpow10[bscale])
+
+	// Compute cos(x) using Taylor series expansion
+	// cos(x) = x^0 / 0! - x^2 / 2! + ... + (-1)^n * x^(2n) / (2n)!
+	for n := int64(1); ; n++ {
+		gcoef.mul(gcoef, hcoef)
+		gcoef.rshDown(gcoef, bscale)
+		ncoef.setInt64((2*n - 1) * (2 * n))
+		gcoef.quo(gcoef, ncoef)
+		if gcoef.sign() == 0 {
+			break
+		}
+		if n%2 == 1 {
+			zcoef.sub(zcoef, gcoef)
+		} else {
+			zcoef.add(zcoef, gcoef)
+		}
+	}
+
+	// Truncation errors can make the sum slightly negative near π/2
+	if zcoef.sign() < 0 {
+		zcoef.setFint(0)
+	}
+
+	z.setBint(zcoef)
+}
+
+// NormalPDF returns the (possibly rounded) probability density function
+// of the [standard normal distribution] at x:
+//
+//	φ(x) = exp(-x² / 2) / √(2π)
+//
+// See also function [NormalCDF].
+//
+// [standard normal distribution]: https://en.wikipedia.org/wiki/Normal_distribution#Standard_normal_distribution
+func NormalPDF(x Decimal) (Decimal, error) {
+	// Special case: underflow
+	if x.CmpAbs(Ten) > 0 {
+		return newSafe(false, 0, MaxScale)
+	}
+
+	// General case
+	xcoef := getBint()
+	defer putBint(xcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	xcoef.setFint(x.coef)
+	xcoef.lsh(xcoef, bscale-x.Scale())
+
+	// Compute e = exp(x² / 2) * √(2π)
+	ecoef.normDenom(xcoef)
+
+	// Compute x = ⌊1 / e⌋
+	xcoef.quo(bpow10[2*bscale], ecoef)
+
+	y, err := newFromBint(false, xcoef, bscale, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing normpdf(%v): %w", x, err)
+	}
+	return y, nil
+}
+
+// NormalCDF returns the (possibly rounded) cumulative distribution function
+// of the [standard normal distribution] at x, that is, the probability that
+// a standard normal random variable is less than or equal to x.
+// NormalCDF uses the series expansion
+//
+//	Φ(x) = 1/2 + φ(x) * (x + x³ / 3 + x⁵ / (3 * 5) + x⁷ / (3 * 5 * 7) + ...)
+//
+// For negative x, the result is obtained by subtracting a value close to 1/2
+// from 1/2, which cancels leading digits.
+// Since intermediate values carry 41 digits after the decimal point,
+// the result is still correctly rounded to [MaxScale] digits after
+// the decimal point, but deep in the left tail it has only a few significant
+// digits:
+//
+//	NormalCDF(-8) = 0.0000000000000006221
+//
+// See also function [NormalPDF].
+//
+// [standard normal distribution]: https://en.wikipedia.org/wiki/Normal_distribution#Standard_normal_distribution
+func NormalCDF(x Decimal) (Decimal, error) {
+	// Special case: saturation
+	if x.CmpAbs(Ten) > 0 {
+		if x.IsNeg() {
+			return newSafe(false, 0, MaxScale)
+		}
+		return newSafe(false, 1, 0)
+	}
+
+	// General case
+	xcoef := getBint()
+	defer putBint(xcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+
+	scoef := getBint()
+	defer putBint(scoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	xcoef.setFint(x.coef)
+	xcoef.lsh(xcoef, bscale-x.Scale())
+
+	// Compute h = x²
+	hcoef.mul(xcoef, xcoef)
+	hcoef.rshDown(hcoef, bscale)
+
+	// Compute s = x + x³ / 3 + x⁵ / (3 * 5) + ...
+	scoef.setBint(xcoef)
+	gcoef.setBint(xcoef)
+	for n := int64(1); ; n++ {
+		gcoef.mul(gcoef, hcoef)
+		gcoef.rshDown(gcoef, bscale)
+		ncoef.setInt64(2*n + 1)
+		gcoef.quo(gcoef, ncoef)
+		if gcoef.sign() == 0 {
+			break
+		}
+		scoef.add(scoef, gcoef)
+	}
+
+	// Compute e = exp(x² / 2) * √(2π)
+	ecoef.normDenom(xcoef)
+
+	// Compute s = ⌊s / e⌋
+	scoef.lsh(scoef, bscale)
+	scoef.quo(scoef, ecoef)
+
+	// Compute s = 1/2 ± s
+	hcoef.hlf(bpow10[bscale])
+	if x.IsNeg() {
+		hcoef.sub(hcoef, scoef)
+		if hcoef.sign() < 0 {
+			hcoef.setFint(0)
+		}
+	} else {
+		hcoef.add(hcoef, scoef)
+	}
+
+	y, err := newFromBint(false, hcoef, bscale, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing normcdf(%v): %w", x, err)
+	}
+	return y, nil
+}
+
+// normDenom calculates z = exp(x² / 2) * √(2π).
+// The argument x must satisfy 0 <= x <= 10, otherwise the result is undefined.
+// The argument x must be represented as a big integer: round(x * 10^41).
+// The result z is represented as a big integer: round(z * 10^41).
+func (z *bint) normDenom(x *bint) {
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	// Compute y = x² / 2
+	ycoef.mul(x, x)
+	ycoef.rshDown(ycoef, bscale)
+	ycoef.hlf(ycoef)
+
+	// Compute z = exp(y) * √(2π)
+	z.exp(ycoef)
+	z.mul(z, bsqrt2pi)
+	z.rshDown(z, bscale)
+}
+
 // Sum returns the (possibly rounded) sum of decimals.
 // It computes d1 + d2 + ... + dn without intermediate rounding.
 //
@@ -2517,37 +4982,110 @@ func sumBint(d ...Decimal) (Decimal, error) {
 	ecoef := getBint()
 	defer putBint(ecoef)
 
+	ecoef.setFint(Zero.coef)
+	eneg, escale := ecoef.accumulate(Zero.IsNeg(), Zero.Scale(), d...)
+
+	return newFromBint(eneg, ecoef, escale, 0)
+}
+
+// accumulate adds decimals to z, where z is the coefficient of a sum
+// with the given sign and scale.
+// The scale of the sum grows to the largest scale of the decimals,
+// so no digits are lost.
+// accumulate returns the sign and the scale of the updated sum.
+func (z *bint) accumulate(neg bool, scale int, d ...Decimal) (bool, int) {
 	fcoef := getBint()
 	defer putBint(fcoef)
 
-	ecoef.setFint(Zero.coef)
-	escale := Zero.Scale()
-	eneg := Zero.IsNeg()
-
 	for _, f := range d {
 		fcoef.setFint(f.coef)
 
 		// Alignment
 		switch {
-		case escale > f.Scale():
-			fcoef.lsh(fcoef, escale-f.Scale())
-		case escale < f.Scale():
-			ecoef.lsh(ecoef, f.Scale()-escale)
-			escale = f.Scale()
+		case scale > f.Scale():
+			fcoef.lsh(fcoef, scale-f.Scale())
+		case scale < f.Scale():
+			z.lsh(z, f.Scale()-scale)
+			scale = f.Scale()
 		}
 
-		// Compute e = e + f
-		if eneg == f.IsNeg() {
-			ecoef.add(ecoef, fcoef)
+		// Compute z = z + f
+		if neg == f.IsNeg() {
+			z.add(z, fcoef)
 		} else {
-			if fcoef.cmp(ecoef) > 0 {
-				eneg = f.IsNeg()
+			if fcoef.cmp(z) > 0 {
+				neg = f.IsNeg()
 			}
-			ecoef.subAbs(ecoef, fcoef)
+			z.subAbs(z, fcoef)
 		}
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
+	return neg, scale
+}
+
+// SumWithScale returns the sum of decimals rounded to exactly the specified
+// number of digits after the decimal point using [rounding half to even].
+// It computes d1 + d2 + ... + dn without intermediate rounding, so the result
+// is rounded only once.
+// This method is useful when the scale of the result, such as the scale of
+// a settlement currency, differs from the scale of the addends.
+// See also function [Sum].
+//
+// SumWithScale returns an error if:
+//   - no arguments are provided;
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the result has more than ([MaxPrec] - scale) digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func SumWithScale(scale int, d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, fmt.Errorf("computing [sum([])]: %w", errInvalidOperation)
+	}
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("computing [sum(%v)]: %w", d, errScaleRange)
+	}
+
+	// General case
+	e, err := sumWithScaleFint(scale, d...)
+	if err != nil {
+		e, err = sumWithScaleBint(scale, d...)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("computing [sum(%v)]: %w", d, err)
+		}
+	}
+
+	return e, nil
+}
+
+// sumWithScaleFint computes the sum of decimals using uint64 arithmetic
+// and rescales it to the given scale.
+func sumWithScaleFint(scale int, d ...Decimal) (Decimal, error) {
+	e, err := sumFint(d...)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if e.Scale() > scale {
+		return e.Round(scale), nil
+	}
+	return newFromFint(e.IsNeg(), e.coef, e.Scale(), scale)
+}
+
+// sumWithScaleBint computes the sum of decimals using *big.Int arithmetic
+// and rescales it to the given scale.
+func sumWithScaleBint(scale int, d ...Decimal) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	ecoef.setFint(Zero.coef)
+	eneg, escale := ecoef.accumulate(Zero.IsNeg(), Zero.Scale(), d...)
+
+	// Rounding
+	if escale > scale {
+		ecoef.rshHalfEven(ecoef, escale-scale)
+		escale = scale
+	}
+
+	return newFromBint(eneg, ecoef, escale, scale)
 }
 
 // SubAbs returns the (possibly rounded) absolute difference between decimals d and e.
@@ -2577,6 +5115,21 @@ func (d Decimal) SubExact(e Decimal, scale int) (Decimal, error) {
 	return d.AddExact(e.Neg(), scale)
 }
 
+// SubStrict is similar to [Decimal.Sub], but it never falls back to
+// *big.Int arithmetic.
+// This method is useful when operands are expected to be small, and the need
+// for a wider intermediate coefficient indicates a data error.
+// See also method [Decimal.AddStrict].
+//
+// SubStrict returns an error if the result cannot be computed using uint64 arithmetic.
+func (d Decimal) SubStrict(e Decimal) (Decimal, error) {
+	f, err := d.addFint(e.Neg(), 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v - %v]: %w", d, e, err)
+	}
+	return f, nil
+}
+
 // Add returns the (possibly rounded) sum of decimals d and e.
 //
 // Add returns an error if the integer part of the result has more than [MaxPrec] digits.
@@ -2606,6 +5159,21 @@ func (d Decimal) AddExact(e Decimal, scale int) (Decimal, error) {
 	return f, nil
 }
 
+// AddStrict is similar to [Decimal.Add], but it never falls back to
+// *big.Int arithmetic.
+// This method is useful when operands are expected to be small, and the need
+// for a wider intermediate coefficient indicates a data error.
+// See also method [Decimal.SubStrict].
+//
+// AddStrict returns an error if the result cannot be computed using uint64 arithmetic.
+func (d Decimal) AddStrict(e Decimal) (Decimal, error) {
+	f, err := d.addFint(e, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v + %v]: %w", d, e, err)
+	}
+	return f, nil
+}
+
 // addFint computes the sum of two decimals using uint64 arithmetic.
 func (d Decimal) addFint(e Decimal, minScale int) (Decimal, error) {
 	dcoef := d.coef
@@ -2834,6 +5402,28 @@ func (d Decimal) addMulBint(e, f Decimal, minScale int) (Decimal, error) {
 	return newFromBint(dneg, dcoef, dscale, minScale)
 }
 
+// Interpolate returns the (possibly rounded) [linear interpolation] between
+// decimals d and e.
+// It computes d + t * (e - d), so that t = 0 gives d and t = 1 gives e.
+// If t is outside the range [0, 1], the result is extrapolated.
+// This method is useful for yield curve interpolation and price ladder construction.
+//
+// Interpolate returns an error if the integer part of the result has more than
+// [MaxPrec] digits.
+//
+// [linear interpolation]: https://en.wikipedia.org/wiki/Linear_interpolation
+func (d Decimal) Interpolate(e, t Decimal) (Decimal, error) {
+	f, err := e.Sub(d)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v + %v * (%v - %v)]: %w", d, t, e, d, err)
+	}
+	f, err = d.AddMul(t, f)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v + %v * (%v - %v)]: %w", d, t, e, d, err)
+	}
+	return f, nil
+}
+
 // SubQuo returns the (possibly rounded) fused quotient-subtraction of decimals d, e, and f.
 // It computes d - e / f with at least double precision during intermediate rounding.
 // This method is useful for improving the accuracy and performance of algorithms
@@ -3026,13 +5616,51 @@ func (d Decimal) Inv() (Decimal, error) {
 	return f, nil
 }
 
+// InvRound returns the inverse of the decimal rounded to the specified number
+// of digits after the decimal point using [rounding half to even].
+// Unlike [Decimal.Inv], the result always has exactly the given scale,
+// and it is rounded only once.
+// See also method [Decimal.QuoRound].
+//
+// InvRound returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the result has more than ([MaxPrec] - scale) digits;
+//   - the decimal is 0.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) InvRound(scale int) (Decimal, error) {
+	f, err := One.QuoRound(d, scale, RoundHalfEven)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("inverting %v: %w", d, err)
+	}
+	return f, nil
+}
+
 // Quo returns the (possibly rounded) quotient of decimals d and e.
 //
-// Quo returns an error if:
+// Quo returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Quo(e Decimal) (Decimal, error) {
+	return d.QuoExact(e, 0)
+}
+
+// QuoInt returns the (possibly rounded) quotient of decimal d and integer n.
+// It is a shorthand for dividing by a decimal with a scale of 0:
+//
+//	price, err := totalCost.QuoInt(units)
+//
+// See also method [Decimal.Quo].
+//
+// QuoInt returns an error if:
 //   - the divisor is 0;
 //   - the integer part of the result has more than [MaxPrec] digits.
-func (d Decimal) Quo(e Decimal) (Decimal, error) {
-	return d.QuoExact(e, 0)
+func (d Decimal) QuoInt(n int) (Decimal, error) {
+	e, err := New(int64(n), 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, n, err)
+	}
+	return d.Quo(e)
 }
 
 // QuoExact is similar to [Decimal.Quo], but it allows you to specify the number of digits
@@ -3127,6 +5755,121 @@ func (d Decimal) quoBint(e Decimal, minScale int) (Decimal, error) {
 	return newFromBint(dneg, dcoef, bscale, minScale)
 }
 
+// QuoRound returns the quotient of decimals d and e rounded to the specified
+// number of digits after the decimal point using the given rounding mode.
+// Unlike [Decimal.Quo], the result always has exactly the given scale,
+// and it is rounded only once.
+// This method is useful when a financial standard mandates a specific
+// rounding rule for the result of a division.
+// See also method [Decimal.QuoExact].
+//
+// QuoRound returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the divisor is 0;
+//   - the integer part of the result has more than ([MaxPrec] - scale) digits.
+func (d Decimal) QuoRound(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, errScaleRange)
+	}
+
+	// Special case: zero divisor
+	if e.IsZero() {
+		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, errDivisionByZero)
+	}
+
+	// General case
+	f, err := d.quoRoundFint(e, scale, mode)
+	if err != nil {
+		f, err = d.quoRoundBint(e, scale, mode)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, err)
+		}
+	}
+
+	return f, nil
+}
+
+// quoRoundFint computes the quotient of two decimals rounded to the given
+// scale using uint64 arithmetic.
+func (d Decimal) quoRoundFint(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	dcoef := d.coef
+	ecoef := e.coef
+	dneg := d.IsNeg() != e.IsNeg()
+
+	// Alignment
+	var ok bool
+	if shift := scale + e.Scale() - d.Scale(); shift > 0 {
+		dcoef, ok = dcoef.lsh(shift)
+	} else {
+		ecoef, ok = ecoef.lsh(-shift)
+	}
+	if !ok {
+		return Decimal{}, errDecimalOverflow
+	}
+
+	// Compute d = d / e
+	dcoef, rcoef, ok := dcoef.quoRem(ecoef)
+	if !ok {
+		return Decimal{}, errDivisionByZero // Should never happen
+	}
+
+	// Rounding
+	h := ecoef - rcoef // r < e / 2 if and only if r < e - r
+	var half int
+	switch {
+	case rcoef < h:
+		half = -1
+	case rcoef > h:
+		half = 1
+	}
+	if mode.roundUp(dneg, dcoef.isOdd(), rcoef != 0, half) {
+		dcoef, ok = dcoef.add(1)
+		if !ok {
+			return Decimal{}, errDecimalOverflow
+		}
+	}
+
+	return newFromFint(dneg, dcoef, scale, scale)
+}
+
+// quoRoundBint computes the quotient of two decimals rounded to the given
+// scale using *big.Int arithmetic.
+// Unlike quoBint, it computes the exact remainder, so the result is
+// rounded only once using the given rounding mode.
+func (d Decimal) quoRoundBint(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+
+	dcoef.setFint(d.coef)
+	ecoef.setFint(e.coef)
+	dneg := d.IsNeg() != e.IsNeg()
+
+	// Alignment
+	if shift := scale + e.Scale() - d.Scale(); shift > 0 {
+		dcoef.lsh(dcoef, shift)
+	} else {
+		ecoef.lsh(ecoef, -shift)
+	}
+
+	// Compute d = d / e
+	dcoef.quoRem(dcoef, ecoef, rcoef)
+
+	// Rounding
+	inexact := rcoef.sign() != 0
+	rcoef.dbl(rcoef) // r = r * 2
+	if mode.roundUp(dneg, dcoef.isOdd(), inexact, rcoef.cmp(ecoef)) {
+		dcoef.inc(dcoef) // d = d + 1
+	}
+
+	return newFromBint(dneg, dcoef, scale, scale)
+}
+
 // QuoRem returns the quotient q and remainder r of decimals d and e
 // such that d = e * q + r, where q is an integer and the sign of the
 // reminder r is the same as the sign of the dividend d.
@@ -3152,6 +5895,25 @@ func (d Decimal) QuoRem(e Decimal) (q, r Decimal, err error) {
 	return q, r, nil
 }
 
+// DivMod is an alias for [Decimal.QuoRem].
+// It returns the quotient q and remainder r of decimals d and e
+// such that d = e * q + r, where q is an integer and the sign of the
+// remainder r is the same as the sign of the dividend d.
+// Note that, despite the name, DivMod uses truncated division,
+// so for operands of different signs the result differs from that of
+// divmod in Python and Ruby, which use [floored division]:
+//
+//	-7.DivMod(2) = (-3, -1), whereas divmod(-7, 2) = (-4, 1)
+//
+// DivMod returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the quotient has more than [MaxPrec] digits.
+//
+// [floored division]: https://en.wikipedia.org/wiki/Modulo#Variants_of_the_definition
+func (d Decimal) DivMod(e Decimal) (q, r Decimal, err error) {
+	return d.QuoRem(e)
+}
+
 // quoRemFint computes the quotient and remainder of two decimals using uint64 arithmetic.
 func (d Decimal) quoRemFint(e Decimal) (q, r Decimal, err error) {
 	dcoef := d.coef
@@ -3254,6 +6016,32 @@ func (d Decimal) Min(e Decimal) Decimal {
 	return e
 }
 
+// MaxAbs returns the decimal with the larger absolute value.
+// If the absolute values are equal, it returns the larger decimal.
+// See also methods [Decimal.CmpAbs], [Decimal.Max].
+func (d Decimal) MaxAbs(e Decimal) Decimal {
+	switch d.CmpAbs(e) {
+	case 1:
+		return d
+	case -1:
+		return e
+	}
+	return d.Max(e)
+}
+
+// MinAbs returns the decimal with the smaller absolute value.
+// If the absolute values are equal, it returns the smaller decimal.
+// See also methods [Decimal.CmpAbs], [Decimal.Min].
+func (d Decimal) MinAbs(e Decimal) Decimal {
+	switch d.CmpAbs(e) {
+	case -1:
+		return d
+	case 1:
+		return e
+	}
+	return d.Min(e)
+}
+
 // Clamp compares decimals and returns:
 //
 //	min if d < min
@@ -3283,6 +6071,68 @@ func (d Decimal) Clamp(min, max Decimal) (Decimal, error) {
 	return d, nil
 }
 
+// Clamp64 is like [Decimal.Clamp] but takes the bounds as unsigned
+// integers, which spares the caller from constructing decimals for them.
+// If d is numerically equal to a bound, d is returned unchanged.
+// Otherwise, a selected bound is returned with a scale of zero.
+//
+// Clamp64 returns an error if:
+//   - lo is greater than hi;
+//   - d is less than lo and lo has more than [MaxPrec] digits.
+func (d Decimal) Clamp64(lo, hi uint64) (Decimal, error) {
+	if lo > hi {
+		return Decimal{}, fmt.Errorf("clamping %v: invalid range", d)
+	}
+	if d.cmpUint64(lo) < 0 {
+		if lo > maxCoef {
+			return Decimal{}, fmt.Errorf("clamping %v: %w", d, overflowError(MaxPrec+1, 0, 0)) // lo has 20 digits
+		}
+		return newUnsafe(false, fint(lo), 0), nil
+	}
+	if d.cmpUint64(hi) > 0 {
+		return newUnsafe(false, fint(hi), 0), nil
+	}
+	return d, nil
+}
+
+// cmpUint64 compares d with an unsigned integer and returns -1, 0, or +1.
+func (d Decimal) cmpUint64(u uint64) int {
+	if d.IsNeg() {
+		return -1
+	}
+	if u > maxCoef {
+		return -1
+	}
+	pow := pow10[d.Scale()]
+	i, f := d.coef/pow, d.coef%pow
+	switch {
+	case i < fint(u):
+		return -1
+	case i > fint(u):
+		return 1
+	case f != 0:
+		return 1
+	}
+	return 0
+}
+
+// Validate checks that d is within the closed interval [lo, hi].
+// The fieldName is used to identify the value in the error message.
+// See also method [Decimal.Clamp].
+//
+// Validate returns an error if:
+//   - lo is greater than hi numerically;
+//   - d is outside [lo, hi], in which case the error wraps [ErrOutOfRange].
+func Validate(d, lo, hi Decimal, fieldName string) error {
+	if lo.Cmp(hi) > 0 {
+		return fmt.Errorf("validating %v: invalid range", fieldName)
+	}
+	if d.Cmp(lo) < 0 || d.Cmp(hi) > 0 {
+		return fmt.Errorf("validating %v: %w: %v is not in [%v, %v]", fieldName, ErrOutOfRange, d, lo, hi)
+	}
+	return nil
+}
+
 // CmpTotal compares decimal representations and returns:
 //
 //	-1 if d < e
@@ -3340,6 +6190,78 @@ func (d Decimal) Less(e Decimal) bool {
 	return d.Cmp(e) < 0
 }
 
+// IsApproxEqual returns true if decimal d is within the relative tolerance
+// pct of decimal e, that is, if |d - e| <= pct * |e|.
+// For example, the following checks that a price is within 0.01% of the reference:
+//
+//	price.IsApproxEqual(reference, decimal.MustParse("0.0001"))
+//
+// The comparison is exact, no rounding is performed.
+// If pct is negative, IsApproxEqual returns false.
+// See also method [Decimal.Equal].
+func (d Decimal) IsApproxEqual(e, pct Decimal) bool {
+	if pct.IsNeg() {
+		return false
+	}
+
+	dcoef := getBint()
+	defer putBint(dcoef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	dcoef.setFint(d.coef)
+	dscale := d.Scale()
+	ecoef.setFint(e.coef)
+
+	// Alignment
+	switch {
+	case dscale > e.Scale():
+		ecoef.lsh(ecoef, dscale-e.Scale())
+	case dscale < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-dscale)
+		dscale = e.Scale()
+	}
+
+	// Compute d = |d - e|
+	if d.IsNeg() == e.IsNeg() {
+		dcoef.subAbs(dcoef, ecoef)
+	} else {
+		dcoef.add(dcoef, ecoef)
+	}
+
+	// Compute f = pct * |e|
+	fcoef.setFint(pct.coef)
+	ecoef.setFint(e.coef)
+	fcoef.mul(fcoef, ecoef)
+	fscale := pct.Scale() + e.Scale()
+
+	// Alignment
+	switch {
+	case dscale > fscale:
+		fcoef.lsh(fcoef, dscale-fscale)
+	case dscale < fscale:
+		dcoef.lsh(dcoef, fscale-dscale)
+	}
+
+	return dcoef.cmp(fcoef) <= 0
+}
+
+// Compare compares decimals and returns the same result as a.Cmp(b).
+// Compare can be passed directly to functions such as [slices.SortFunc]:
+//
+//	slices.SortFunc(prices, decimal.Compare)
+//
+// See also method [Decimal.Cmp].
+//
+// [slices.SortFunc]: https://pkg.go.dev/slices#SortFunc
+func Compare(a, b Decimal) int {
+	return a.Cmp(b)
+}
+
 // Cmp compares decimals and returns:
 //
 //	-1 if d < e
@@ -3513,3 +6435,234 @@ func (n NullDecimal) MarshalBSONValue() (typ byte, data []byte, err error) {
 	}
 	return n.Decimal.MarshalBSONValue()
 }
+
+// Range represents an arithmetic progression of decimals starting at From and
+// moving toward To in increments of Step.
+// Since decimal addition is exact, the values do not drift the way
+// floating-point accumulations do.
+// Its zero value is an empty range.
+type Range struct {
+	From, To, Step Decimal
+	Inclusive      bool // Inclusive indicates whether To is yielded when it is reached exactly.
+}
+
+// All returns an iterator over decimals From, From + Step, From + 2 * Step, ...
+// that do not pass To.
+// If Step is zero or points away from To, the iterator yields no values.
+// The iterator stops early if the next value cannot be represented exactly.
+// This method is useful for generating strike grids and coupon schedules.
+func (r Range) All() iter.Seq[Decimal] {
+	return func(yield func(Decimal) bool) {
+		dir := r.Step.Sign()
+		if dir == 0 {
+			return
+		}
+		for d := r.From; ; {
+			switch c := d.Cmp(r.To); {
+			case c == dir:
+				return
+			case c == 0 && !r.Inclusive:
+				return
+			}
+			if !yield(d) {
+				return
+			}
+			var err error
+			d, err = d.AddExact(r.Step, max(d.Scale(), r.Step.Scale()))
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// RunningTotal accumulates a sum of decimals without intermediate rounding.
+// Internally it uses *big.Int arithmetic, so neither the number of digits
+// nor the number of additions is limited.
+// Its zero value is a total of 0, ready to use.
+// A RunningTotal must not be copied after first use.
+//
+// RunningTotal is not safe for concurrent use by multiple goroutines.
+type RunningTotal struct {
+	neg   bool // indicates whether the total is negative
+	scale int  // number of digits after the decimal point
+	coef  bint // absolute value of the total without decimal point
+}
+
+// Add adds decimal d to the total.
+func (t *RunningTotal) Add(d Decimal) {
+	t.neg, t.scale = t.coef.accumulate(t.neg, t.scale, d)
+}
+
+// Sub subtracts decimal d from the total.
+func (t *RunningTotal) Sub(d Decimal) {
+	t.neg, t.scale = t.coef.accumulate(t.neg, t.scale, d.Neg())
+}
+
+// Reset sets the total to 0.
+func (t *RunningTotal) Reset() {
+	t.neg = false
+	t.scale = 0
+	t.coef.setFint(0)
+}
+
+// Total returns the (possibly rounded) total.
+// The scale of the total is the largest scale of the accumulated decimals.
+//
+// Total returns an error if the integer part of the total has more than
+// [MaxPrec] digits.
+func (t *RunningTotal) Total() (Decimal, error) {
+	tcoef := getBint()
+	defer putBint(tcoef)
+
+	tcoef.setBint(&t.coef)
+
+	d, err := newFromBint(t.neg, tcoef, t.scale, 0)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("computing running total: %w", err)
+	}
+	return d, nil
+}
+
+// AtomicDecimal is a decimal value that can be loaded and stored atomically.
+// It is useful for values that are read frequently and updated rarely,
+// such as the last traded price.
+// Its zero value holds a decimal 0, ready to use.
+// An AtomicDecimal must not be copied after first use.
+//
+// AtomicDecimal is safe for concurrent use by multiple goroutines.
+type AtomicDecimal struct {
+	v atomic.Pointer[Decimal]
+}
+
+// Load atomically loads and returns the decimal stored in a.
+func (a *AtomicDecimal) Load() Decimal {
+	p := a.v.Load()
+	if p == nil {
+		return Decimal{}
+	}
+	return *p
+}
+
+// Store atomically stores decimal d into a.
+func (a *AtomicDecimal) Store(d Decimal) {
+	a.v.Store(&d)
+}
+
+// CompareAndSwap executes the compare-and-swap operation for a.
+// Decimals are compared by representation, so 1.0 and 1.00 are considered
+// different.
+// See also method [Decimal.CmpTotal].
+//
+//nolint:revive
+func (a *AtomicDecimal) CompareAndSwap(old, new Decimal) bool {
+	for {
+		p := a.v.Load()
+		var d Decimal
+		if p != nil {
+			d = *p
+		}
+		if d != old {
+			return false
+		}
+		if a.v.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
+
+// Percentage represents a decimal expressed in percent, so that
+// a Percentage of 5 stands for the decimal 0.05.
+// Keeping percentages in a distinct type prevents rates that have already
+// been converted to decimal form from being scaled by 100 once more.
+// Its zero value is 0%.
+type Percentage Decimal
+
+// PercentageFromDecimal converts a decimal to a percentage by multiplying
+// it by 100:
+//
+//	PercentageFromDecimal(0.05)  = 5%
+//	PercentageFromDecimal(0.125) = 12.5%
+//
+// See also method [Percentage.ToDecimal].
+//
+// PercentageFromDecimal returns an error if the integer part of the result
+// has more than [MaxPrec] digits.
+func PercentageFromDecimal(d Decimal) (Percentage, error) {
+	if d.Scale() >= 2 {
+		return Percentage(newUnsafe(d.IsNeg(), d.coef, d.Scale()-2)), nil
+	}
+	coef, ok := d.coef.lsh(2 - d.Scale())
+	if !ok {
+		return Percentage{}, fmt.Errorf("converting %v to percentage: %w", d, errDecimalOverflow)
+	}
+	return Percentage(newUnsafe(d.IsNeg(), coef, 0)), nil
+}
+
+// ParsePercentage converts a string to a (possibly rounded) percentage.
+// The string must be a numeric string accepted by [Parse],
+// optionally followed by a percent sign:
+//
+//	12.5%
+//	-0.25
+//
+// ParsePercentage returns an error under the same conditions as [Parse].
+func ParsePercentage(s string) (Percentage, error) {
+	d, err := Parse(strings.TrimSuffix(s, "%"))
+	if err != nil {
+		return Percentage{}, fmt.Errorf("parsing percentage: %w", err)
+	}
+	return Percentage(d), nil
+}
+
+// ToDecimal converts the percentage to a (possibly rounded) decimal by
+// dividing it by 100:
+//
+//	5%.ToDecimal()    = 0.05
+//	12.5%.ToDecimal() = 0.125
+//
+// If the result has more than [MaxScale] digits after the decimal point,
+// it is rounded using [rounding half to even] (banker's rounding).
+// See also constructor [PercentageFromDecimal].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (p Percentage) ToDecimal() Decimal {
+	d := Decimal(p)
+	scale := d.Scale() + 2
+	if scale <= MaxScale {
+		return newUnsafe(d.IsNeg(), d.coef, scale)
+	}
+	coef := d.coef.rshHalfEven(scale - MaxScale)
+	return newUnsafe(d.IsNeg(), coef, MaxScale)
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// the percentage as a numeric string followed by a percent sign.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (p Percentage) String() string {
+	return Decimal(p).String() + "%"
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// MarshalText always returns a numeric string followed by a percent sign,
+// so that a percentage is encoded as a JSON string such as "12.5%".
+// See also method [Percentage.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (p Percentage) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// UnmarshalText supports the same formats as [ParsePercentage].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (p *Percentage) UnmarshalText(text []byte) error {
+	var err error
+	*p, err = ParsePercentage(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshaling %T: %w", Percentage{}, err)
+	}
+	return nil
+}
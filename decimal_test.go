@@ -6,10 +6,17 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
 	"unsafe"
 )
 
@@ -42,6 +49,10 @@ func TestDecimal_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement fmt.Formatter", d)
 	}
+	_, ok = d.(fmt.GoStringer)
+	if !ok {
+		t.Errorf("%T does not implement fmt.GoStringer", d)
+	}
 	_, ok = d.(json.Marshaler)
 	if !ok {
 		t.Errorf("%T does not implement json.Marshaler", d)
@@ -67,6 +78,10 @@ func TestDecimal_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement driver.Valuer", d)
 	}
+	_, ok = d.(io.WriterTo)
+	if !ok {
+		t.Errorf("%T does not implement io.WriterTo", d)
+	}
 
 	d = &Decimal{}
 	_, ok = d.(json.Unmarshaler)
@@ -85,6 +100,10 @@ func TestDecimal_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement sql.Scanner", d)
 	}
+	_, ok = d.(flag.Value)
+	if !ok {
+		t.Errorf("%T does not implement flag.Value", d)
+	}
 }
 
 func TestNew(t *testing.T) {
@@ -159,6 +178,81 @@ func TestMustNew(t *testing.T) {
 	})
 }
 
+func TestNewFromMicros(t *testing.T) {
+	tests := []struct {
+		value int64
+		want  string
+	}{
+		{0, "0.000000"},
+		{1, "0.000001"},
+		{-1, "-0.000001"},
+		{1234500, "1.234500"},
+		{-1234500, "-1.234500"},
+		{math.MaxInt64, "9223372036854.775807"},
+		{math.MinInt64, "-9223372036854.775808"},
+	}
+	for _, tt := range tests {
+		got := NewFromMicros(tt.value)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("NewFromMicros(%v) = %q, want %q", tt.value, got, want)
+		}
+	}
+}
+
+func TestNewFromFixed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value int64
+			scale int
+			want  string
+		}{
+			{0, -3, "0"},
+			{0, -20, "0"},
+			{0, math.MinInt, "0"},
+			{0, 0, "0"},
+			{0, 2, "0.00"},
+			{12345, 2, "123.45"},
+			{12345, 0, "12345"},
+			{12345, -2, "1234500"},
+			{-12345, -2, "-1234500"},
+			{-12345, 19, "-0.0000000000000012345"},
+			{1, -18, "1000000000000000000"},
+			{math.MinInt64, 0, "-9223372036854775808"},
+			{math.MaxInt64, 0, "9223372036854775807"},
+		}
+		for _, tt := range tests {
+			got, err := NewFromFixed(tt.value, tt.scale)
+			if err != nil {
+				t.Errorf("NewFromFixed(%v, %v) failed: %v", tt.value, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromFixed(%v, %v) = %q, want %q", tt.value, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			value int64
+			scale int
+		}{
+			"scale range 1": {1, 20},
+			"overflow 1":    {1, -19},
+			"overflow 2":    {math.MaxInt64, -1},
+			"overflow 3":    {math.MinInt64, -1},
+		}
+		for _, tt := range tests {
+			_, err := NewFromFixed(tt.value, tt.scale)
+			if err == nil {
+				t.Errorf("NewFromFixed(%v, %v) did not fail", tt.value, tt.scale)
+			}
+		}
+	})
+}
+
 func TestNewFromInt64(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -510,6 +604,180 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestParseInto(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"0", "1.23", "-1.230", "1e5", "0.0000000000000000001"}
+		var got Decimal
+		for _, tt := range tests {
+			err := ParseInto(&got, tt)
+			if err != nil {
+				t.Errorf("ParseInto(%q) failed: %v", tt, err)
+				continue
+			}
+			want := MustParse(tt)
+			if got != want {
+				t.Errorf("ParseInto(%q) = %q, want %q", tt, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"empty 1":    "",
+			"invalid 1":  "1.2.3",
+			"overflow 1": "10000000000000000000",
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				got := MustParse("1.23")
+				err := ParseInto(&got, tt)
+				if err == nil {
+					t.Errorf("ParseInto(%q) did not fail", tt)
+				}
+				want := MustParse("1.23")
+				if got != want {
+					t.Errorf("ParseInto(%q) modified destination to %q, want %q", tt, got, want)
+				}
+			})
+		}
+	})
+}
+
+func TestParseEuropean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{"0", "0"},
+			{"0,00", "0.00"},
+			{"1,5", "1.5"},
+			{"-0,5", "-0.5"},
+			{"+12", "12"},
+			{"1.234,56", "1234.56"},
+			{"1234,56", "1234.56"},
+			{"1.234.567,89", "1234567.89"},
+			{"-999.999", "-999999"},
+			{"1.000.000", "1000000"},
+			{"9.999.999.999.999.999.999", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			got, err := ParseEuropean(tt.s)
+			if err != nil {
+				t.Errorf("ParseEuropean(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseEuropean(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"empty 1":     "",
+			"sign 1":      "-",
+			"comma 1":     "1,2,3",
+			"period 1":    "1,234.5",
+			"group 1":     "1.23,4",
+			"group 2":     "1234.567",
+			"group 3":     ".123",
+			"group 4":     "1..234",
+			"group 5":     "1.2345",
+			"group 6":     "1.234.",
+			"invalid 1":   "1e5",
+			"invalid 2":   "1 234,5",
+			"invalid 3":   "1,5x",
+			"overflow 1":  "10.000.000.000.000.000.000",
+			"us format 1": "1,234.56",
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseEuropean(tt)
+				if err == nil {
+					t.Errorf("ParseEuropean(%q) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
+func TestNewFromReader(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want, rest string
+		}{
+			{"1.23", "1.23", ""},
+			{"-1.230,4.56", "-1.230", ",4.56"},
+			{"1e-2|", "0.01", "|"},
+			{"0 1", "0", " 1"},
+			{"+15\n", "15", "\n"},
+			{strings.Repeat("0", 330), "0", ""},
+		}
+		for _, tt := range tests {
+			r := strings.NewReader(tt.s)
+			got, err := NewFromReader(r)
+			if err != nil {
+				t.Errorf("NewFromReader(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("NewFromReader(%q) = %q, want %q", tt.s, got, want)
+			}
+			rest, err := io.ReadAll(r)
+			if err != nil {
+				t.Errorf("io.ReadAll() failed: %v", err)
+				continue
+			}
+			if string(rest) != tt.rest {
+				t.Errorf("NewFromReader(%q) left %q unread, want %q", tt.s, rest, tt.rest)
+			}
+		}
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		r := iotest.OneByteReader(strings.NewReader("12.5;7"))
+		got, err := NewFromReader(r)
+		if err != nil {
+			t.Fatalf("NewFromReader() failed: %v", err)
+		}
+		want := MustParse("12.5")
+		if got != want {
+			t.Errorf("NewFromReader() = %q, want %q", got, want)
+		}
+		got, err = NewFromReader(r)
+		if err != nil {
+			t.Fatalf("NewFromReader() failed: %v", err)
+		}
+		want = MustParse("7")
+		if got != want {
+			t.Errorf("NewFromReader() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]io.Reader{
+			"empty 1":    strings.NewReader(""),
+			"empty 2":    strings.NewReader(",1"),
+			"invalid 1":  strings.NewReader("1.2.3"),
+			"invalid 2":  strings.NewReader("--1"),
+			"overflow 1": strings.NewReader("10000000000000000000"),
+			"length 1":   strings.NewReader(strings.Repeat("0", 331)),
+			"reader 1":   iotest.ErrReader(fmt.Errorf("read failed")),
+			"reader 2":   iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("12"))),
+		}
+		for name, r := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewFromReader(r)
+				if err == nil {
+					t.Errorf("NewFromReader() did not fail")
+				}
+			})
+		}
+	})
+}
+
 func TestMustParse(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		defer func() {
@@ -521,6 +789,123 @@ func TestMustParse(t *testing.T) {
 	})
 }
 
+func TestOrZero(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"1.23", "1.23"},
+		{"-1.23", "-1.23"},
+		{"0.00", "0.00"},
+		{".", "0"},
+		{"1e", "0"},
+	}
+	for _, tt := range tests {
+		got := OrZero(Parse(tt.s))
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("OrZero(Parse(%q)) = %q, want %q", tt.s, got, want)
+		}
+	}
+}
+
+func TestOrPanic(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		got := OrPanic(Parse("1.23"))
+		want := MustParse("1.23")
+		if got != want {
+			t.Errorf("OrPanic(Parse(\"1.23\")) = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("OrPanic(Parse(\".\")) did not panic")
+			}
+		}()
+		OrPanic(Parse("."))
+	})
+}
+
+func TestParseLenient(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{"0", "0"},
+			{"1_000_000.50", "1000000.50"},
+			{"-1_000", "-1000"},
+			{"+1_0", "10"},
+			{"0.000_001", "0.000001"},
+			{"1_2.3_4e1_0", "123400000000"},
+			{"9_999_999_999_999_999_999", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			got, err := ParseLenient(tt.s)
+			if err != nil {
+				t.Errorf("ParseLenient(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("ParseLenient(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"leading underscore 1":  "_1",
+			"leading underscore 2":  "-_1",
+			"trailing underscore 1": "1_",
+			"trailing underscore 2": "1_.5",
+			"double underscore 1":   "1__0",
+			"underscore dot 1":      "1._5",
+			"underscore exponent 1": "1_e5",
+			"underscore only 1":     "_",
+			"overflow 1":            "10_000_000_000_000_000_000",
+			"invalid 1":             "1_a",
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseLenient(tt)
+				if err == nil {
+					t.Errorf("ParseLenient(%q) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Set(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var got Decimal
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&got, "min-price", "minimum acceptable price")
+		err := fs.Parse([]string{"-min-price", "-1.230"})
+		if err != nil {
+			t.Fatalf("Parse() failed: %v", err)
+		}
+		want := MustParse("-1.230")
+		if got != want {
+			t.Errorf("Set(%q) = %q, want %q", "-1.230", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		got := MustParse("1.23")
+		err := got.Set("1.2.3")
+		if err == nil {
+			t.Errorf("Set(%q) did not fail", "1.2.3")
+		}
+		want := MustParse("1.23")
+		if got != want {
+			t.Errorf("Set(%q) modified decimal to %q, want %q", "1.2.3", got, want)
+		}
+	})
+}
+
 func TestDecimalUnmarshalText(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		d := Decimal{}
@@ -578,214 +963,605 @@ func TestDecimalUnmarshalJSON(t *testing.T) {
 	})
 }
 
-func TestDecimalUnmarshalBSONValue(t *testing.T) {
+// yamlScalar imitates the unmarshal function passed by YAML decoders
+// for a scalar node, where nil represents null.
+func yamlScalar(s *string) func(any) error {
+	return func(v any) error {
+		p, ok := v.(**string)
+		if !ok {
+			return fmt.Errorf("cannot unmarshal into %T", v)
+		}
+		*p = s
+		return nil
+	}
+}
+
+func TestDecimalUnmarshalYAML(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			typ  byte
-			data []byte
+			s    *string
 			want string
 		}{
-			{1, []byte{0x0, 0x0, 0x0, 0x0, 0x80, 0x0, 0xf0, 0x3f}, "1.0001220703125"},
-			{2, []byte{0x15, 0x0, 0x0, 0x0, 0x2d, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x0}, "-9999999999999999999"},
-			{10, nil, "0"},
-			{16, []byte{0xff, 0xff, 0xff, 0x7f}, "2147483647"},
-			{18, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}, "9223372036854775807"},
-			{19, []byte{0xf1, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3a, 0x30}, "1.265"},
+			{nil, "0"},
+			{ptr("-9999999999999999999.0"), "-9999999999999999999"},
+			{ptr("1.23"), "1.23"},
+			{ptr("1.8e5"), "180000"},
 		}
 		for _, tt := range tests {
-			got := Decimal{}
-			err := got.UnmarshalBSONValue(tt.typ, tt.data)
+			var got Decimal
+			err := got.UnmarshalYAML(yamlScalar(tt.s))
 			if err != nil {
-				t.Errorf("UnmarshalBSONValue(%v, % x) failed: %v", tt.typ, tt.data, err)
+				t.Errorf("UnmarshalYAML(%v) failed: %v", tt.s, err)
 				continue
 			}
 			want := MustParse(tt.want)
 			if got != want {
-				t.Errorf("UnmarshalBSONValue(%v, % x) = %q, want %q", tt.typ, tt.data, got, want)
+				t.Errorf("UnmarshalYAML(%v) = %q, want %q", tt.s, got, want)
 			}
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
 		d := Decimal{}
-		err := d.UnmarshalBSONValue(12, nil)
+		err := d.UnmarshalYAML(yamlScalar(ptr("-1.1.1")))
 		if err == nil {
-			t.Errorf("UnmarshalBSONValue(12, nil) did not fail")
+			t.Errorf("UnmarshalYAML(\"-1.1.1\") did not fail")
+		}
+		err = d.UnmarshalYAML(func(any) error { return fmt.Errorf("mapping node") })
+		if err == nil {
+			t.Errorf("UnmarshalYAML(mapping) did not fail")
 		}
 	})
 }
 
-func TestParseBSONFloat64(t *testing.T) {
+func TestDecimal_MarshalYAML(t *testing.T) {
+	tests := []string{"0", "0.00", "1.23", "-1.23", "9999999999999999999"}
+	for _, tt := range tests {
+		d := MustParse(tt)
+		got, err := d.MarshalYAML()
+		if err != nil {
+			t.Errorf("%q.MarshalYAML() failed: %v", d, err)
+			continue
+		}
+		if got != tt {
+			t.Errorf("%q.MarshalYAML() = %v, want %q", d, got, tt)
+		}
+	}
+}
+
+func TestDecimal_EncodeProto(t *testing.T) {
+	tests := []struct {
+		d    string
+		want []byte
+	}{
+		{"0", []byte{0x0a, 0x01, '0'}},
+		{"1.23", []byte{0x0a, 0x04, '1', '.', '2', '3'}},
+		{"-1.23", []byte{0x0a, 0x05, '-', '1', '.', '2', '3'}},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.EncodeProto()
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%q.EncodeProto() = % x, want % x", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_DecodeProto(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			b    []byte
 			want string
 		}{
-			// Zero
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, "0"},
-
-			// Negative zero
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}, "0"},
-
-			// Integers
-			{[]byte{0x2a, 0x1b, 0xf5, 0xf4, 0x10, 0x22, 0xb1, 0xc3}, "-1234567892123200000"},
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0xbf}, "-1"},
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0x3f}, "1"},
-			{[]byte{0x2a, 0x1b, 0xf5, 0xf4, 0x10, 0x22, 0xb1, 0x43}, "1234567892123200000"},
-
-			// Floats
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x80, 0x0, 0xf0, 0xbf}, "-1.0001220703125"},
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x80, 0x0, 0xf0, 0x3f}, "1.0001220703125"},
+			{[]byte{0x0a, 0x01, '0'}, "0"},
+			{[]byte{0x0a, 0x04, '1', '.', '2', '3'}, "1.23"},
+			{[]byte{0x0a, 0x05, '-', '1', '.', '2', '3'}, "-1.23"},
+			{[]byte{0x0a, 0x05, '1', '.', '2', '3', '0'}, "1.230"},
+			// Unknown fields
+			{[]byte{0x10, 0x96, 0x01, 0x0a, 0x01, '5'}, "5"},
+			{[]byte{0x0a, 0x01, '5', 0x19, 1, 2, 3, 4, 5, 6, 7, 8}, "5"},
+			{[]byte{0x22, 0x02, 'x', 'y', 0x0a, 0x01, '5'}, "5"},
+			{[]byte{0x2d, 1, 2, 3, 4, 0x0a, 0x01, '5'}, "5"},
+			// Repeated field
+			{[]byte{0x0a, 0x01, '5', 0x0a, 0x01, '6'}, "6"},
 		}
-
 		for _, tt := range tests {
-			got, err := parseBSONFloat64(tt.b)
+			var got Decimal
+			err := got.DecodeProto(tt.b)
 			if err != nil {
-				t.Errorf("parseBSONFloat64(%v) failed: %v", tt.b, err)
+				t.Errorf("DecodeProto(% x) failed: %v", tt.b, err)
 				continue
 			}
 			want := MustParse(tt.want)
 			if got != want {
-				t.Errorf("parseBSONFloat64(%v) = %q, want %q", tt.b, got, want)
+				t.Errorf("DecodeProto(% x) = %q, want %q", tt.b, got, want)
 			}
+		}
+	})
 
+	t.Run("round trip", func(t *testing.T) {
+		for _, tt := range corpus {
+			d, err := newSafe(tt.neg, fint(tt.coef), tt.scale)
+			if err != nil {
+				continue
+			}
+			var got Decimal
+			err = got.DecodeProto(d.EncodeProto())
+			if err != nil {
+				t.Errorf("DecodeProto(%q.EncodeProto()) failed: %v", d, err)
+				continue
+			}
+			if got != d {
+				t.Errorf("DecodeProto(%q.EncodeProto()) = %q", d, got)
+			}
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
 		tests := map[string][]byte{
-			"length 1": {},
-			"length 2": {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
-			"length 3": {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
-			"nan 1":    {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf8, 0x7f},
-			"nan 2":    {0x12, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf8, 0x7f},
-			"inf 1":    {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0x7f},
-			"inf 2":    {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0xff},
+			"empty":          {},
+			"missing field":  {0x10, 0x01},
+			"invalid key":    {0x8a},
+			"invalid varint": {0x10, 0x96},
+			"invalid length": {0x0a, 0x05, '1'},
+			"truncated 64":   {0x19, 1, 2, 3},
+			"truncated 32":   {0x2d, 1, 2},
+			"wire type":      {0x0b},
+			"invalid value":  {0x0a, 0x01, 'x'},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				_, err := parseBSONFloat64(tt)
+				var d Decimal
+				err := d.DecodeProto(tt)
 				if err == nil {
-					t.Errorf("parseBSONFloat64(%v) did not fail", tt)
+					t.Errorf("DecodeProto(% x) did not fail", tt)
 				}
 			})
 		}
 	})
 }
 
-func TestParseBSONString(t *testing.T) {
+func TestDecimal_MarshalCBOR(t *testing.T) {
+	tests := []struct {
+		d    string
+		want []byte
+	}{
+		{"0", []byte{0xc4, 0x82, 0x00, 0x00}},
+		{"273.15", []byte{0xc4, 0x82, 0x21, 0x19, 0x6a, 0xb3}},
+		{"-1.5", []byte{0xc4, 0x82, 0x20, 0x2e}},
+		{"100", []byte{0xc4, 0x82, 0x00, 0x18, 0x64}},
+		{"9999999999999999999", []byte{0xc4, 0x82, 0x00, 0x1b, 0x8a, 0xc7, 0x23, 0x04, 0x89, 0xe7, 0xff, 0xff}},
+		{"-0.0000000000000000001", []byte{0xc4, 0x82, 0x32, 0x20}},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.MarshalCBOR()
+		if err != nil {
+			t.Errorf("%q.MarshalCBOR() failed: %v", d, err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%q.MarshalCBOR() = % x, want % x", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalCBOR(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			b    []byte
 			want string
 		}{
-			{[]byte{0x15, 0x0, 0x0, 0x0, 0x2d, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x0}, "-9999999999999999999"},
-			{[]byte{0x17, 0x0, 0x0, 0x0, 0x2d, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0x0}, "-0.0000000000000000001"},
-			{[]byte{0x16, 0x0, 0x0, 0x0, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x0}, "0.0000000000000000000"},
-			{[]byte{0x2, 0x0, 0x0, 0x0, 0x30, 0x0}, "0"},
-			{[]byte{0x16, 0x0, 0x0, 0x0, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0x0}, "0.0000000000000000001"},
-			{[]byte{0x2, 0x0, 0x0, 0x0, 0x31, 0x0}, "1"},
-			{[]byte{0x3, 0x0, 0x0, 0x0, 0x30, 0x31, 0x0}, "1"},
-			{[]byte{0x15, 0x0, 0x0, 0x0, 0x33, 0x2e, 0x31, 0x34, 0x31, 0x35, 0x39, 0x32, 0x36, 0x35, 0x33, 0x35, 0x38, 0x39, 0x37, 0x39, 0x33, 0x32, 0x33, 0x38, 0x0}, "3.141592653589793238"},
-			{[]byte{0x3, 0x0, 0x0, 0x0, 0x31, 0x30, 0x0}, "10"},
-			{[]byte{0x14, 0x0, 0x0, 0x0, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x0}, "9999999999999999999"},
+			{[]byte{0xc4, 0x82, 0x00, 0x00}, "0"},
+			{[]byte{0xc4, 0x82, 0x14, 0x00}, "0"},
+			{[]byte{0xc4, 0x82, 0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}, "0"},
+			{[]byte{0xc4, 0x82, 0x22, 0x00}, "0.000"},
+			{[]byte{0xc4, 0x82, 0x3b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}, "0.0000000000000000000"},
+			{[]byte{0xc4, 0x82, 0x21, 0x19, 0x6a, 0xb3}, "273.15"},
+			{[]byte{0xc4, 0x82, 0x20, 0x2e}, "-1.5"},
+			{[]byte{0xc4, 0x82, 0x02, 0x05}, "500"},
+			{[]byte{0xc4, 0x82, 0x38, 0x18, 0x01}, "0.0000000000000000000"},
+			{[]byte{0xc4, 0x82, 0x20, 0x3b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "-1844674407370955162"},
+			{[]byte{0xd8, 0x04, 0x82, 0x20, 0x2e}, "-1.5"},
 		}
 		for _, tt := range tests {
-			got, err := parseBSONString(tt.b)
+			var got Decimal
+			err := got.UnmarshalCBOR(tt.b)
 			if err != nil {
-				t.Errorf("parseBSONString(%v) failed: %v", tt.b, err)
+				t.Errorf("UnmarshalCBOR(% x) failed: %v", tt.b, err)
 				continue
 			}
 			want := MustParse(tt.want)
 			if got != want {
-				t.Errorf("parseBSONString(%v) = %q, want %q", tt.b, got, want)
+				t.Errorf("UnmarshalCBOR(% x) = %q, want %q", tt.b, got, want)
 			}
+		}
+	})
 
+	t.Run("round trip", func(t *testing.T) {
+		for _, tt := range corpus {
+			d, err := newSafe(tt.neg, fint(tt.coef), tt.scale)
+			if err != nil {
+				continue
+			}
+			b, err := d.MarshalCBOR()
+			if err != nil {
+				t.Errorf("%q.MarshalCBOR() failed: %v", d, err)
+				continue
+			}
+			var got Decimal
+			err = got.UnmarshalCBOR(b)
+			if err != nil {
+				t.Errorf("UnmarshalCBOR(%q.MarshalCBOR()) failed: %v", d, err)
+				continue
+			}
+			if got != d {
+				t.Errorf("UnmarshalCBOR(%q.MarshalCBOR()) = %q", d, got)
+			}
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
 		tests := map[string][]byte{
-			"length 1":     {},
-			"length 2":     {0x0, 0x0, 0x0, 0x0},
-			"length 3":     {0x2, 0x0, 0x0, 0x0, 0x30},
-			"terminator 1": {0x2, 0x0, 0x0, 0x0, 0x30, 0x30},
+			"empty":         {},
+			"missing tag":   {0x82, 0x00, 0x00},
+			"wrong tag":     {0xc5, 0x82, 0x00, 0x00},
+			"wrong array":   {0xc4, 0x83, 0x00, 0x00, 0x00},
+			"indefinite":    {0xc4, 0x9f, 0x00, 0x00, 0xff},
+			"float exp":     {0xc4, 0x82, 0xf9, 0x3c, 0x00, 0x00},
+			"bignum":        {0xc4, 0x82, 0x00, 0xc2, 0x41, 0x01},
+			"truncated":     {0xc4, 0x82, 0x00, 0x19, 0x01},
+			"trailing":      {0xc4, 0x82, 0x00, 0x00, 0x00},
+			"exponent 1":    {0xc4, 0x82, 0x19, 0x01, 0x4b, 0x01},
+			"exponent 2":    {0xc4, 0x82, 0x39, 0x01, 0x4a, 0x01},
+			"overflow 1":    {0xc4, 0x82, 0x13, 0x01},
+			"overflow 2":    {0xc4, 0x82, 0x00, 0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			"missing array": {0xc4},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				_, err := parseBSONString(tt)
+				var d Decimal
+				err := d.UnmarshalCBOR(tt)
 				if err == nil {
-					t.Errorf("parseBSONString(%v) did not fail", tt)
+					t.Errorf("UnmarshalCBOR(% x) did not fail", tt)
 				}
 			})
 		}
 	})
 }
 
-func TestParseBSONInt32(t *testing.T) {
+func TestDecimal_MarshalMsgPack(t *testing.T) {
+	tests := []string{"0", "0.00", "1.23", "-1.23", "-0.0000000000000000001", "9999999999999999999"}
+	for _, tt := range tests {
+		d := MustParse(tt)
+		got, err := d.MarshalMsgPack()
+		if err != nil {
+			t.Errorf("%q.MarshalMsgPack() failed: %v", d, err)
+			continue
+		}
+		want := append([]byte{0xa0 | byte(len(tt))}, tt...)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q.MarshalMsgPack() = % x, want % x", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalMsgPack(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			b    []byte
 			want string
 		}{
-			{[]byte{0x0, 0x0, 0x0, 0x80}, "-2147483648"},
-			{[]byte{0xff, 0xff, 0xff, 0xff}, "-1"},
-			{[]byte{0x0, 0x0, 0x0, 0x0}, "0"},
-			{[]byte{0x1, 0x0, 0x0, 0x0}, "1"},
-			{[]byte{0xff, 0xff, 0xff, 0x7f}, "2147483647"},
+			// fixstr, str 8, str 16, str 32
+			{[]byte{0xa4, '1', '.', '2', '3'}, "1.23"},
+			{[]byte{0xd9, 0x05, '-', '1', '.', '2', '3'}, "-1.23"},
+			{[]byte{0xda, 0x00, 0x03, '1', 'e', '2'}, "100"},
+			{[]byte{0xdb, 0x00, 0x00, 0x00, 0x01, '0'}, "0"},
+
+			// positive fixint, negative fixint
+			{[]byte{0x00}, "0"},
+			{[]byte{0x7f}, "127"},
+			{[]byte{0xff}, "-1"},
+			{[]byte{0xe0}, "-32"},
+
+			// uint 8, uint 16, uint 32, uint 64
+			{[]byte{0xcc, 0xff}, "255"},
+			{[]byte{0xcd, 0xff, 0xff}, "65535"},
+			{[]byte{0xce, 0xff, 0xff, 0xff, 0xff}, "4294967295"},
+			{[]byte{0xcf, 0x8a, 0xc7, 0x23, 0x04, 0x89, 0xe7, 0xff, 0xff}, "9999999999999999999"},
+
+			// int 8, int 16, int 32, int 64
+			{[]byte{0xd0, 0x80}, "-128"},
+			{[]byte{0xd1, 0x80, 0x00}, "-32768"},
+			{[]byte{0xd2, 0x80, 0x00, 0x00, 0x00}, "-2147483648"},
+			{[]byte{0xd3, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, "-9223372036854775808"},
+
+			// float 32, float 64
+			{[]byte{0xca, 0x3f, 0xc0, 0x00, 0x00}, "1.5"},
+			{[]byte{0xcb, 0x3f, 0xb9, 0x99, 0x99, 0x99, 0x99, 0x99, 0x9a}, "0.1"},
 		}
 		for _, tt := range tests {
-			got, err := parseBSONInt32(tt.b)
+			var got Decimal
+			err := got.UnmarshalMsgPack(tt.b)
 			if err != nil {
-				t.Errorf("parseBSONInt32(%v) failed: %v", tt.b, err)
+				t.Errorf("UnmarshalMsgPack(% x) failed: %v", tt.b, err)
 				continue
 			}
 			want := MustParse(tt.want)
 			if got != want {
-				t.Errorf("parseBSONInt32(%v) = %q, want %q", tt.b, got, want)
+				t.Errorf("UnmarshalMsgPack(% x) = %q, want %q", tt.b, got, want)
 			}
-
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
 		tests := map[string][]byte{
-			"length 1": {},
-			"length 2": {0x0, 0x0, 0x0},
-			"length 3": {0x0, 0x0, 0x0, 0x0, 0x0},
+			"empty":         {},
+			"nil":           {0xc0},
+			"bool":          {0xc3},
+			"empty str":     {0xa0},
+			"short str":     {0xa4, '1', '.', '2'},
+			"long str":      {0xa2, '1', '2', '3'},
+			"invalid str":   {0xa1, 'x'},
+			"short uint":    {0xcd, 0x01},
+			"long uint":     {0xcc, 0x01, 0x02},
+			"short float":   {0xcb, 0x3f, 0xb9},
+			"infinity":      {0xca, 0x7f, 0x80, 0x00, 0x00},
+			"overflow":      {0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			"short str 16":  {0xda, 0x00},
+			"long fixint 1": {0x05, 0xff, 0xff},
+			"long fixint 2": {0xff, 0x00},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				_, err := parseBSONInt32(tt)
+				var d Decimal
+				err := d.UnmarshalMsgPack(tt)
 				if err == nil {
-					t.Errorf("parseBSONInt32(%v) did not fail", tt)
+					t.Errorf("UnmarshalMsgPack(% x) did not fail", tt)
 				}
 			})
 		}
 	})
 }
 
-func TestParseBSONInt64(t *testing.T) {
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDecimal_MarshalCSV(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "0"},
+		{"-0.00", "0.00"},
+		{"1.230", "1.230"},
+		{"-9999999999999999999", "-9999999999999999999"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.MarshalCSV()
+		if err != nil {
+			t.Errorf("%q.MarshalCSV() failed: %v", d, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q.MarshalCSV() = %q, want %q", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalCSV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"0", "0"},
+			{"-1.230", "-1.230"},
+			{"1e3", "1000"},
+			{"9999999999999999999", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			var got Decimal
+			err := got.UnmarshalCSV(tt.s)
+			if err != nil {
+				t.Errorf("UnmarshalCSV(%q) failed: %v", tt.s, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("UnmarshalCSV(%q) = %q, want %q", tt.s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", " 1", "1.2.3", "abc"}
+		for _, s := range tests {
+			var d Decimal
+			err := d.UnmarshalCSV(s)
+			if err == nil {
+				t.Errorf("UnmarshalCSV(%q) did not fail", s)
+			}
+		}
+	})
+}
+
+func TestDecimal_ScanBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"0", "0.00", "1.23", "-1.23", "-0.0000000000000000001", "9999999999999999999"}
+		var buf bytes.Buffer
+		for i, tt := range tests {
+			data, err := MustParse(tt).MarshalBinary()
+			if err != nil {
+				t.Fatalf("%q.MarshalBinary() failed: %v", tt, err)
+			}
+			buf.Write(data)
+			buf.WriteString([]string{" ", "\n", "\t ", "\r\n"}[i%4])
+		}
+		for _, tt := range tests {
+			var got Decimal
+			err := got.ScanBinary(&buf)
+			if err != nil {
+				t.Errorf("ScanBinary() failed: %v", err)
+				continue
+			}
+			want := MustParse(tt)
+			if got != want {
+				t.Errorf("ScanBinary() = %q, want %q", got, want)
+			}
+		}
+		var d Decimal
+		err := d.ScanBinary(&buf)
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("ScanBinary() = %v, want %v", err, io.EOF)
+		}
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		r := iotest.OneByteReader(strings.NewReader("  1.5 -2\n0.25"))
+		for _, tt := range []string{"1.5", "-2", "0.25"} {
+			var got Decimal
+			err := got.ScanBinary(r)
+			if err != nil {
+				t.Errorf("ScanBinary() failed: %v", err)
+				continue
+			}
+			want := MustParse(tt)
+			if got != want {
+				t.Errorf("ScanBinary() = %q, want %q", got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]io.Reader{
+			"empty 1":    strings.NewReader(""),
+			"empty 2":    strings.NewReader(" \n\t"),
+			"invalid 1":  strings.NewReader("1.2.3"),
+			"invalid 2":  strings.NewReader("1.5-2"),
+			"invalid 3":  strings.NewReader(",1"),
+			"overflow 1": strings.NewReader("10000000000000000000"),
+			"reader 1":   iotest.ErrReader(io.ErrUnexpectedEOF),
+		}
+		for name, r := range tests {
+			t.Run(name, func(t *testing.T) {
+				var d Decimal
+				err := d.ScanBinary(r)
+				if err == nil {
+					t.Errorf("ScanBinary() did not fail")
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_WriteTo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"0", "0.00", "1.23", "-1.23", "-0.0000000000000000001", "9999999999999999999"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			var buf bytes.Buffer
+			n, err := d.WriteTo(&buf)
+			if err != nil {
+				t.Errorf("%q.WriteTo() failed: %v", d, err)
+				continue
+			}
+			if got, want := buf.String(), d.String(); got != want {
+				t.Errorf("%q.WriteTo() = %q, want %q", d, got, want)
+			}
+			if n != int64(buf.Len()) {
+				t.Errorf("%q.WriteTo() = %v, want %v", d, n, buf.Len())
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := MustParse("1.23")
+		_, err := d.WriteTo(failingWriter{})
+		if err == nil {
+			t.Errorf("%q.WriteTo() did not fail", d)
+		}
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("write failed")
+}
+
+func TestDecimalUnmarshalBSONValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			typ  byte
+			data []byte
+			want string
+		}{
+			{1, []byte{0x0, 0x0, 0x0, 0x0, 0x80, 0x0, 0xf0, 0x3f}, "1.0001220703125"},
+			{2, []byte{0x15, 0x0, 0x0, 0x0, 0x2d, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x0}, "-9999999999999999999"},
+			{10, nil, "0"},
+			{16, []byte{0xff, 0xff, 0xff, 0x7f}, "2147483647"},
+			{18, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}, "9223372036854775807"},
+			{19, []byte{0xf1, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3a, 0x30}, "1.265"},
+		}
+		for _, tt := range tests {
+			got := Decimal{}
+			err := got.UnmarshalBSONValue(tt.typ, tt.data)
+			if err != nil {
+				t.Errorf("UnmarshalBSONValue(%v, % x) failed: %v", tt.typ, tt.data, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("UnmarshalBSONValue(%v, % x) = %q, want %q", tt.typ, tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		d := Decimal{}
+		err := d.UnmarshalBSONValue(12, nil)
+		if err == nil {
+			t.Errorf("UnmarshalBSONValue(12, nil) did not fail")
+		}
+	})
+}
+
+func TestParseBSONFloat64(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			b    []byte
 			want string
 		}{
-			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}, "-9223372036854775808"},
-			{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "-1"},
+			// Zero
 			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, "0"},
-			{[]byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, "1"},
-			{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}, "9223372036854775807"},
+
+			// Negative zero
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}, "0"},
+
+			// Integers
+			{[]byte{0x2a, 0x1b, 0xf5, 0xf4, 0x10, 0x22, 0xb1, 0xc3}, "-1234567892123200000"},
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0xbf}, "-1"},
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0x3f}, "1"},
+			{[]byte{0x2a, 0x1b, 0xf5, 0xf4, 0x10, 0x22, 0xb1, 0x43}, "1234567892123200000"},
+
+			// Floats
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x80, 0x0, 0xf0, 0xbf}, "-1.0001220703125"},
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x80, 0x0, 0xf0, 0x3f}, "1.0001220703125"},
 		}
+
 		for _, tt := range tests {
-			got, err := parseBSONInt64(tt.b)
+			got, err := parseBSONFloat64(tt.b)
 			if err != nil {
-				t.Errorf("parseBSONInt64(%v) failed: %v", tt.b, err)
+				t.Errorf("parseBSONFloat64(%v) failed: %v", tt.b, err)
 				continue
 			}
 			want := MustParse(tt.want)
 			if got != want {
-				t.Errorf("parseBSONInt64(%v) = %q, want %q", tt.b, got, want)
+				t.Errorf("parseBSONFloat64(%v) = %q, want %q", tt.b, got, want)
 			}
 
 		}
@@ -796,19 +1572,158 @@ func TestParseBSONInt64(t *testing.T) {
 			"length 1": {},
 			"length 2": {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 			"length 3": {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+			"nan 1":    {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf8, 0x7f},
+			"nan 2":    {0x12, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf8, 0x7f},
+			"inf 1":    {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0x7f},
+			"inf 2":    {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf0, 0xff},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				_, err := parseBSONInt64(tt)
+				_, err := parseBSONFloat64(tt)
 				if err == nil {
-					t.Errorf("parseBSONInt64(%v) did not fail", tt)
+					t.Errorf("parseBSONFloat64(%v) did not fail", tt)
 				}
 			})
 		}
 	})
 }
 
-func TestParseIEEEDecimal128(t *testing.T) {
+func TestParseBSONString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b    []byte
+			want string
+		}{
+			{[]byte{0x15, 0x0, 0x0, 0x0, 0x2d, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x0}, "-9999999999999999999"},
+			{[]byte{0x17, 0x0, 0x0, 0x0, 0x2d, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0x0}, "-0.0000000000000000001"},
+			{[]byte{0x16, 0x0, 0x0, 0x0, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x0}, "0.0000000000000000000"},
+			{[]byte{0x2, 0x0, 0x0, 0x0, 0x30, 0x0}, "0"},
+			{[]byte{0x16, 0x0, 0x0, 0x0, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0x0}, "0.0000000000000000001"},
+			{[]byte{0x2, 0x0, 0x0, 0x0, 0x31, 0x0}, "1"},
+			{[]byte{0x3, 0x0, 0x0, 0x0, 0x30, 0x31, 0x0}, "1"},
+			{[]byte{0x15, 0x0, 0x0, 0x0, 0x33, 0x2e, 0x31, 0x34, 0x31, 0x35, 0x39, 0x32, 0x36, 0x35, 0x33, 0x35, 0x38, 0x39, 0x37, 0x39, 0x33, 0x32, 0x33, 0x38, 0x0}, "3.141592653589793238"},
+			{[]byte{0x3, 0x0, 0x0, 0x0, 0x31, 0x30, 0x0}, "10"},
+			{[]byte{0x14, 0x0, 0x0, 0x0, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x39, 0x0}, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			got, err := parseBSONString(tt.b)
+			if err != nil {
+				t.Errorf("parseBSONString(%v) failed: %v", tt.b, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("parseBSONString(%v) = %q, want %q", tt.b, got, want)
+			}
+
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]byte{
+			"length 1":     {},
+			"length 2":     {0x0, 0x0, 0x0, 0x0},
+			"length 3":     {0x2, 0x0, 0x0, 0x0, 0x30},
+			"terminator 1": {0x2, 0x0, 0x0, 0x0, 0x30, 0x30},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := parseBSONString(tt)
+				if err == nil {
+					t.Errorf("parseBSONString(%v) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
+func TestParseBSONInt32(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b    []byte
+			want string
+		}{
+			{[]byte{0x0, 0x0, 0x0, 0x80}, "-2147483648"},
+			{[]byte{0xff, 0xff, 0xff, 0xff}, "-1"},
+			{[]byte{0x0, 0x0, 0x0, 0x0}, "0"},
+			{[]byte{0x1, 0x0, 0x0, 0x0}, "1"},
+			{[]byte{0xff, 0xff, 0xff, 0x7f}, "2147483647"},
+		}
+		for _, tt := range tests {
+			got, err := parseBSONInt32(tt.b)
+			if err != nil {
+				t.Errorf("parseBSONInt32(%v) failed: %v", tt.b, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("parseBSONInt32(%v) = %q, want %q", tt.b, got, want)
+			}
+
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]byte{
+			"length 1": {},
+			"length 2": {0x0, 0x0, 0x0},
+			"length 3": {0x0, 0x0, 0x0, 0x0, 0x0},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := parseBSONInt32(tt)
+				if err == nil {
+					t.Errorf("parseBSONInt32(%v) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
+func TestParseBSONInt64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b    []byte
+			want string
+		}{
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x80}, "-9223372036854775808"},
+			{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "-1"},
+			{[]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, "0"},
+			{[]byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, "1"},
+			{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}, "9223372036854775807"},
+		}
+		for _, tt := range tests {
+			got, err := parseBSONInt64(tt.b)
+			if err != nil {
+				t.Errorf("parseBSONInt64(%v) failed: %v", tt.b, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("parseBSONInt64(%v) = %q, want %q", tt.b, got, want)
+			}
+
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]byte{
+			"length 1": {},
+			"length 2": {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+			"length 3": {0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := parseBSONInt64(tt)
+				if err == nil {
+					t.Errorf("parseBSONInt64(%v) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
+func TestParseIEEEDecimal128(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			b    []byte
@@ -1308,6 +2223,131 @@ func TestDecimal_String(t *testing.T) {
 	})
 }
 
+func TestDecimal_StringLocale(t *testing.T) {
+	custom := Locale{DecimalSep: '·', GroupSep: '\'', GroupSize: 4}
+	tests := []struct {
+		d    string
+		loc  Locale
+		want string
+	}{
+		{"0", LocaleUS, "0"},
+		{"0.00", LocaleEU, "0,00"},
+		{"-0.5", LocaleEU, "-0,5"},
+		{"123", LocaleUS, "123"},
+		{"1234", LocaleUS, "1,234"},
+		{"-1234.5", LocaleUS, "-1,234.5"},
+		{"1234567.89", LocaleUS, "1,234,567.89"},
+		{"1234567.89", LocaleEU, "1.234.567,89"},
+		{"1234567.89", LocaleIN, "12,34,567.89"},
+		{"123456", LocaleIN, "1,23,456"},
+		{"12345", LocaleIN, "12,345"},
+		{"-9999999999999999999", LocaleUS, "-9,999,999,999,999,999,999"},
+		{"9999999999999999999", LocaleIN, "99,99,99,99,99,99,99,99,999"},
+		{"0.0000000000000000001", LocaleEU, "0,0000000000000000001"},
+		{"1234567.89", custom, "123'4567·89"},
+		{"1234567.89", Locale{DecimalSep: '.'}, "1234567.89"},
+		{"-1234567.89", Locale{}, "-1234567.89"},
+		{"1234567.89", Locale{GroupSep: ' ', GroupSize: 3}, "1 234 567.89"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.StringLocale(tt.loc)
+		if got != tt.want {
+			t.Errorf("%q.StringLocale(%+v) = %q, want %q", d, tt.loc, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_StrFormat(t *testing.T) {
+	tests := []struct {
+		d, pattern, want string
+	}{
+		{"1234.5", "#,##0.00", "1,234.50"},
+		{"1234567.891", "#,##0.00", "1,234,567.89"},
+		{"-1234567.891", "#,##0.00", "-1,234,567.89"},
+		{"1234567", "#,##,###", "1,234,567"},
+		{"1234567", "#,####", "123,4567"},
+		{"1234.5", "0000.###", "1234.5"},
+		{"12.5", "0000.###", "0012.5"},
+		{"12", "0000.###", "0012"},
+		{"0.125", "#.##", ".12"},
+		{"0.135", "#.##", ".14"},
+		{"0.125", "0.##", "0.12"},
+		{"0", "#.##", "0"},
+		{"0", "#", "0"},
+		{"0", "#.00", ".00"},
+		{"-0.001", "#,##0.00", "0.00"},
+		{"-7", "$#,##0.00 USD", "-$7.00 USD"},
+		{"1.20", "0.0#", "1.2"},
+		{"1.2", "0.0###", "1.2"},
+		{"1.23456", "0.0###", "1.2346"},
+		{"999.999", "#,##0.00", "1,000.00"},
+		{"1.5", "0", "2"},
+		{"2.5", "0", "2"},
+		{"9999999999999999999", "#,##0.00", "9,999,999,999,999,999,999.00"},
+		{"0.0000000000000000001", "0.#####################", "0.0000000000000000001"},
+		{"1.23", "", "1"},
+		{"1.23", "total: ", "total: 1"},
+		{"1234.5", "0.00 pcs.", "1234.50 pcs."},
+		{"1234.5", "#,##0.00 USD, net.", "1,234.50 USD, net."},
+		{"-1234.5", "$#,##0.0 (approx. 1,000s)", "-$1,234.5 (approx. 1,000s)"},
+		{"7", "0 items #1", "7 items #1"},
+		{"12", "'No.' 000", "No. 012"},
+		{"-12", "'#'0", "-#12"},
+		{"5", "0 'o''clock'", "5 o'clock"},
+		{"5", "0 o''clock", "5 o'clock"},
+		{"1234.5", "'1,000s: '#,##0.0", "1,000s: 1,234.5"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.StrFormat(tt.pattern)
+		if got != tt.want {
+			t.Errorf("%q.StrFormat(%q) = %q, want %q", d, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	usd := NewFormatter().Precision(2).GroupSeparator(',').Currency("$", CurrencyBefore)
+	eur := NewFormatter().Precision(2).GroupSeparator('.').DecimalPoint(',').Currency(" EUR", CurrencyAfter)
+	tests := []struct {
+		f    Formatter
+		d    string
+		want string
+	}{
+		{Formatter{}, "1.55", "1.55"},
+		{Formatter{}.Precision(3), "1", "1.000"},
+		{Formatter{}.GroupSeparator(','), "1234567", "1,234,567"},
+		{Formatter{}.DecimalPoint(0).Precision(1), "1", "1.0"},
+		{NewFormatter(), "0", "0"},
+		{NewFormatter(), "-1234.5670", "-1234.5670"},
+		{NewFormatter(), "0.0000000000000000001", "0.0000000000000000001"},
+		{NewFormatter().Precision(-5), "1.230", "1.230"},
+		{NewFormatter().Precision(0), "2.5", "2"},
+		{NewFormatter().Precision(1), "0.25", "0.2"},
+		{NewFormatter().Precision(3), "1", "1.000"},
+		{NewFormatter().Precision(3), "1.2", "1.200"},
+		{NewFormatter().GroupSeparator(' '), "1234567.891", "1 234 567.891"},
+		{NewFormatter().GroupSeparator(','), "123", "123"},
+		{NewFormatter().DecimalPoint(','), "1.5", "1,5"},
+		{usd, "0", "$0.00"},
+		{usd, "-0.001", "$0.00"},
+		{usd, "1234.5", "$1,234.50"},
+		{usd, "-1234.5", "-$1,234.50"},
+		{usd, "9999999999999999999", "$9,999,999,999,999,999,999.00"},
+		{eur, "1234567.891", "1.234.567,89 EUR"},
+		{eur, "-7", "-7,00 EUR"},
+		{usd.Precision(0).Currency("USD ", CurrencyBefore), "999.5", "USD 1,000"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := tt.f.Format(d)
+		if got != tt.want {
+			t.Errorf("%+v.Format(%q) = %q, want %q", tt.f, d, got, tt.want)
+		}
+	}
+}
+
 func TestDecimal_Float64(t *testing.T) {
 	tests := []struct {
 		d         string
@@ -1451,6 +2491,121 @@ func TestDecimal_Int64(t *testing.T) {
 	}
 }
 
+func TestDecimal_AsMicros(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			want int64
+		}{
+			{"0", 0},
+			{"0.0000000000000000000", 0},
+			{"-0.000000", 0},
+			{"1", 1000000},
+			{"-1.5", -1500000},
+			{"1.234500", 1234500},
+			{"1.2345678", 1234567},
+			{"-1.2345678", -1234567},
+			{"0.0000009", 0},
+			{"9223372036854.775807", math.MaxInt64},
+			{"-9223372036854.775808", math.MinInt64},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.AsMicros()
+			if err != nil {
+				t.Errorf("%q.AsMicros() failed: %v", d, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.AsMicros() = %v, want %v", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"9223372036854.775808", "-9223372036854.775809", "9999999999999999999"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.AsMicros()
+			if err == nil {
+				t.Errorf("%q.AsMicros() did not fail", d)
+			}
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		for _, v := range []int64{0, 1, -1, 1234500, math.MaxInt64, math.MinInt64} {
+			got, err := NewFromMicros(v).AsMicros()
+			if err != nil {
+				t.Errorf("NewFromMicros(%v).AsMicros() failed: %v", v, err)
+				continue
+			}
+			if got != v {
+				t.Errorf("NewFromMicros(%v).AsMicros() = %v, want %v", v, got, v)
+			}
+		}
+	})
+}
+
+func TestDecimal_ToFixed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  int64
+		}{
+			{"0", 0, 0},
+			{"0.00", 5, 0},
+			{"0", 25, 0},
+			{"0.0000000000000000000", 100, 0},
+			{"0", -25, 0},
+			{"123.456", 2, 12345},
+			{"123.456", 3, 123456},
+			{"123.456", 5, 12345600},
+			{"123.456", 0, 123},
+			{"123.456", -2, 1},
+			{"123.456", -3, 0},
+			{"-123.456", 2, -12345},
+			{"-123.456", -2, -1},
+			{"-0.999", 0, 0},
+			{"9223372036854775807", 0, math.MaxInt64},
+			{"-9223372036854775808", 0, math.MinInt64},
+			{"0.9223372036854775807", 19, math.MaxInt64},
+			{"1", -100, 0},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.ToFixed(tt.scale)
+			if err != nil {
+				t.Errorf("%q.ToFixed(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.ToFixed(%v) = %v, want %v", d, tt.scale, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     string
+			scale int
+		}{
+			"overflow 1": {"9223372036854775808", 0},
+			"overflow 2": {"-9223372036854775809", 0},
+			"overflow 3": {"1", 19},
+			"overflow 4": {"1", 100},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.ToFixed(tt.scale)
+			if err == nil {
+				t.Errorf("%q.ToFixed(%v) did not fail", d, tt.scale)
+			}
+		}
+	})
+}
+
 func TestDecimal_Scan(t *testing.T) {
 	t.Run("float64", func(t *testing.T) {
 		tests := []struct {
@@ -1612,6 +2767,25 @@ func TestDecimal_Scan(t *testing.T) {
 	})
 }
 
+func TestDecimal_GoString(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "decimal.Decimal{neg:false, scale:0, coef:0}"},
+		{"123.456", "decimal.Decimal{neg:false, scale:3, coef:123456}"},
+		{"-0.0000000000000000001", "decimal.Decimal{neg:true, scale:19, coef:1}"},
+		{"9999999999999999999", "decimal.Decimal{neg:false, scale:0, coef:9999999999999999999}"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.GoString()
+		if got != tt.want {
+			t.Errorf("%q.GoString() = %q, want %q", d, got, tt.want)
+		}
+	}
+}
+
 func TestDecimal_Format(t *testing.T) {
 	tests := []struct {
 		d, format, want string
@@ -1655,6 +2829,8 @@ func TestDecimal_Format(t *testing.T) {
 		{"12.34", "%010v", "0000012.34"},
 		{"12.34", "%+10v", "    +12.34"},
 		{"12.34", "%-10v", "12.34     "},
+		{"-12.34", "%#v", "decimal.Decimal{neg:true, scale:2, coef:1234}"},
+		{"-12.34", "%#10v", "decimal.Decimal{neg:true, scale:2, coef:1234}"}, // width is ignored
 
 		// %k verb
 		{"12.34", "%k", "1234%"},
@@ -1735,22 +2911,122 @@ func TestDecimal_Format(t *testing.T) {
 	}
 }
 
-func TestDecimal_Prec(t *testing.T) {
-	tests := []struct {
-		d    string
-		want int
-	}{
-		{"0000", 0},
-		{"000", 0},
-		{"00", 0},
-		{"0", 0},
-		{"0.000", 0},
-		{"0.00", 0},
-		{"0.0", 0},
-		{"0", 0},
-		{"0.0000000000000000001", 1},
-		{"0.000000000000000001", 1},
-		{"0.00000000000000001", 1},
+func TestDecimal_Pointer(t *testing.T) {
+	tests := []string{"0", "0.00", "1.23", "-1.23", "9999999999999999999"}
+	for _, tt := range tests {
+		d := MustParse(tt)
+		got := d.Pointer()
+		if got == nil {
+			t.Errorf("%q.Pointer() = nil", d)
+			continue
+		}
+		if *got != d {
+			t.Errorf("*%q.Pointer() = %q, want %q", d, *got, d)
+		}
+		if got == d.Pointer() {
+			t.Errorf("%q.Pointer() returned the same pointer twice", d)
+		}
+	}
+}
+
+func TestDecimal_Pred(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "-1"},
+			{"0.0", "-0.1"},
+			{"1.23", "1.22"},
+			{"-1.23", "-1.24"},
+			{"0.01", "0.00"},
+			{"1.00", "0.99"},
+			{"0.0000000000000000001", "0.0000000000000000000"},
+			{"9999999999999999999", "9999999999999999998"},
+			{"-9999999999999999998", "-9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Pred()
+			if err != nil {
+				t.Errorf("%q.Pred() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Pred() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"-9999999999999999999", "-0.9999999999999999999"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.Pred()
+			if err == nil {
+				t.Errorf("%q.Pred() did not fail", d)
+			}
+		}
+	})
+}
+
+func TestDecimal_Succ(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "1"},
+			{"0.0", "0.1"},
+			{"1.23", "1.24"},
+			{"-1.23", "-1.22"},
+			{"-0.1", "0.0"},
+			{"0.99", "1.00"},
+			{"-0.0000000000000000001", "0.0000000000000000000"},
+			{"9999999999999999998", "9999999999999999999"},
+			{"-9999999999999999999", "-9999999999999999998"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Succ()
+			if err != nil {
+				t.Errorf("%q.Succ() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Succ() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"9999999999999999999", "0.9999999999999999999", "99999999999999999.99"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.Succ()
+			if err == nil {
+				t.Errorf("%q.Succ() did not fail", d)
+			}
+		}
+	})
+}
+
+func TestDecimal_Prec(t *testing.T) {
+	tests := []struct {
+		d    string
+		want int
+	}{
+		{"0000", 0},
+		{"000", 0},
+		{"00", 0},
+		{"0", 0},
+		{"0.000", 0},
+		{"0.00", 0},
+		{"0.0", 0},
+		{"0", 0},
+		{"0.0000000000000000001", 1},
+		{"0.000000000000000001", 1},
+		{"0.00000000000000001", 1},
 		{"0.0000000000000001", 1},
 		{"0.000000000000001", 1},
 		{"0.00000000000001", 1},
@@ -1816,6 +3092,172 @@ func TestDecimal_Prec(t *testing.T) {
 	}
 }
 
+func TestDecimal_IsMaxScale(t *testing.T) {
+	tests := []struct {
+		d    string
+		want bool
+	}{
+		{"0", false},
+		{"0.0000000000000000000", true},
+		{"0.0000000000000000001", true},
+		{"-0.0000000000000000001", true},
+		{"0.1000000000000000000", true},
+		{"0.9999999999999999999", true},
+		{"1", false},
+		{"0.000000000000000001", false},
+
+		// 19 digits in the coefficient, but fewer after the decimal point
+		{"1.000000000000000000", false},
+		{"-1.000000000000000000", false},
+		{"1234567890123456789", false},
+		{"9999999999999999999", false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.IsMaxScale()
+		if got != tt.want {
+			t.Errorf("%q.IsMaxScale() = %v, want %v", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_IsUnity(t *testing.T) {
+	tests := []struct {
+		d    string
+		want bool
+	}{
+		{"0", false},
+		{"1", true},
+		{"1.0000000000000000000", true},
+		{"-1", false},
+		{"-1.00", false},
+		{"0.9999999999999999999", false},
+		{"10", false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.IsUnity()
+		if got != tt.want {
+			t.Errorf("%q.IsUnity() = %v, want %v", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_IsNaN(t *testing.T) {
+	for _, tt := range corpus {
+		d, err := newSafe(tt.neg, fint(tt.coef), tt.scale)
+		if err != nil {
+			continue
+		}
+		if d.IsNaN() {
+			t.Errorf("%q.IsNaN() = true, want false", d)
+		}
+		if d.IsInf() {
+			t.Errorf("%q.IsInf() = true, want false", d)
+		}
+	}
+}
+
+func TestDecimal_BitLen(t *testing.T) {
+	tests := []struct {
+		d    string
+		want int
+	}{
+		{"0", 0},
+		{"0.000", 0},
+		{"1", 1},
+		{"-1", 1},
+		{"0.1", 1},
+		{"2", 2},
+		{"0.255", 8},
+		{"-256", 9},
+		{"4294967295", 32},
+		{"4294967296", 33},
+		{"9223372036854775807", 63},
+		{"9999999999999999999", 64},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.BitLen()
+		if got != tt.want {
+			t.Errorf("%q.BitLen() = %v, want %v", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_ToScientific(t *testing.T) {
+	tests := []struct {
+		d            string
+		wantMantissa string
+		wantExponent int
+	}{
+		{"0", "0", 0},
+		{"0.000", "0", 0},
+		{"1", "1", 0},
+		{"-1", "-1", 0},
+		{"1.500", "1.500", 0},
+		{"12300.456", "1.2300456", 4},
+		{"-12300", "-1.2300", 4},
+		{"0.005", "5", -3},
+		{"0.0050", "5.0", -3},
+		{"0.0000000000000000001", "1", -19},
+		{"9999999999999999999", "9.999999999999999999", 18},
+		{"0.9999999999999999999", "9.999999999999999999", -1},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		gotMantissa, gotExponent := d.ToScientific()
+		wantMantissa := MustParse(tt.wantMantissa)
+		if gotMantissa != wantMantissa || gotExponent != tt.wantExponent {
+			t.Errorf("%q.ToScientific() = (%q, %v), want (%q, %v)", d, gotMantissa, gotExponent, wantMantissa, tt.wantExponent)
+		}
+	}
+}
+
+func TestDecimal_UnscaledValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			want int64
+		}{
+			{"0", 0},
+			{"0.000", 0},
+			{"123.456", 123456},
+			{"-123.456", -123456},
+			{"1.00", 100},
+			{"0.0000000000000000001", 1},
+			{"9223372036854775807", math.MaxInt64},
+			{"-9.223372036854775808", math.MinInt64},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.UnscaledValue()
+			if err != nil {
+				t.Errorf("%q.UnscaledValue() failed: %v", d, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.UnscaledValue() = %v, want %v", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "9223372036854775808",
+			"overflow 2": "-9.223372036854775809",
+			"overflow 3": "0.9999999999999999999",
+		}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.UnscaledValue()
+			if err == nil {
+				t.Errorf("%q.UnscaledValue() did not fail", d)
+			}
+		}
+	})
+}
+
 func TestDecimal_Rescale(t *testing.T) {
 	tests := []struct {
 		d     string
@@ -2091,102 +3533,342 @@ func TestDecimal_Round(t *testing.T) {
 	}
 }
 
-func TestDecimal_Trunc(t *testing.T) {
+func TestDecimal_RoundWithMode(t *testing.T) {
+	// Tests from Java's RoundingMode documentation
+	values := []string{"5.5", "2.5", "1.6", "1.1", "1.0", "-1.0", "-1.1", "-1.6", "-2.5", "-5.5"}
+	tests := map[RoundingMode][]string{
+		RoundUp:       {"6", "3", "2", "2", "1", "-1", "-2", "-2", "-3", "-6"},
+		RoundDown:     {"5", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-5"},
+		RoundCeiling:  {"6", "3", "2", "2", "1", "-1", "-1", "-1", "-2", "-5"},
+		RoundFloor:    {"5", "2", "1", "1", "1", "-1", "-2", "-2", "-3", "-6"},
+		RoundHalfUp:   {"6", "3", "2", "1", "1", "-1", "-1", "-2", "-3", "-6"},
+		RoundHalfDown: {"5", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-5"},
+		RoundHalfEven: {"6", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-6"},
+	}
+	for mode, wants := range tests {
+		for i, v := range values {
+			d := MustParse(v)
+			got := d.RoundWithMode(0, mode)
+			want := MustParse(wants[i])
+			if got != want {
+				t.Errorf("%q.RoundWithMode(0, %v) = %q, want %q", d, mode, got, want)
+			}
+		}
+	}
+
+	t.Run("scale", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			{"0", 2, RoundUp, "0"},
+			{"0.000", 2, RoundUp, "0.00"},
+			{"1.2345", 5, RoundDown, "1.2345"},
+			{"1.2345", -1, RoundUp, "2"},
+			{"1.2345", 3, RoundHalfUp, "1.235"},
+			{"1.2345", 3, RoundHalfDown, "1.234"},
+			{"1.2345", 3, RoundHalfEven, "1.234"},
+			{"-0.001", 2, RoundCeiling, "0.00"},
+			{"-0.001", 2, RoundFloor, "-0.01"},
+			{"0.9999999999999999999", 0, RoundUp, "1"},
+			{"9999999999999999999", 0, RoundUp, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got := d.RoundWithMode(tt.scale, tt.mode)
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.RoundWithMode(%v, %v) = %q, want %q", d, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+}
+
+func TestDecimal_RoundAway(t *testing.T) {
 	tests := []struct {
 		d     string
 		scale int
 		want  string
 	}{
-		// Zeros
-		{"0", -1, "0"},
 		{"0", 0, "0"},
-		{"0", 1, "0"},
-		{"0", 2, "0"},
-		{"0", 19, "0"},
-		{"0.0", 1, "0.0"},
-		{"0.00", 2, "0.00"},
-		{"0.000000000", 19, "0.000000000"},
-		{"0.000000000", 0, "0"},
-		{"0.000000000", 1, "0.0"},
-		{"0.000000000", 2, "0.00"},
-
-		// Tests from GDA
-		{"2.17", 0, "2"},
-		{"2.17", 1, "2.1"},
-		{"2.17", 2, "2.17"},
-		{"2.17", 9, "2.17"},
-		{"1.2345", 2, "1.23"},
-		{"1.2355", 2, "1.23"},
-		{"1.2345", 9, "1.2345"},
-		{"9.9999", 2, "9.99"},
-		{"0.0001", 2, "0.00"},
-		{"0.001", 2, "0.00"},
-		{"0.009", 2, "0.00"},
-
-		// Some extra tests
-		{"0.03", 2, "0.03"},
-		{"0.02", 2, "0.02"},
-		{"0.01", 2, "0.01"},
-		{"0.00", 2, "0.00"},
-		{"-0.01", 2, "-0.01"},
-		{"-0.02", 2, "-0.02"},
-		{"-0.03", 2, "-0.03"},
-		{"0.0049", 2, "0.00"},
-		{"0.0051", 2, "0.00"},
-		{"0.0149", 2, "0.01"},
-		{"0.0151", 2, "0.01"},
-		{"-0.0049", 2, "0.00"},
-		{"-0.0051", 2, "-0.00"},
-		{"-0.0149", 2, "-0.01"},
-		{"-0.0151", 2, "-0.01"},
-		{"0.0050", 2, "0.00"},
-		{"0.0150", 2, "0.01"},
-		{"0.0250", 2, "0.02"},
-		{"0.0350", 2, "0.03"},
-		{"-0.0050", 2, "0.00"},
-		{"-0.0150", 2, "-0.01"},
-		{"-0.0250", 2, "-0.02"},
-		{"-0.0350", 2, "-0.03"},
-		{"3.0448", 2, "3.04"},
-		{"3.0450", 2, "3.04"},
-		{"3.0452", 2, "3.04"},
-		{"3.0956", 2, "3.09"},
-
-		// Tests from Wikipedia
-		{"1.8", 0, "1"},
-		{"1.5", 0, "1"},
-		{"1.2", 0, "1"},
-		{"0.8", 0, "0"},
-		{"0.5", 0, "0"},
-		{"0.2", 0, "0"},
-		{"-0.2", 0, "0"},
-		{"-0.5", 0, "0"},
-		{"-0.8", 0, "0"},
-		{"-1.2", 0, "-1"},
-		{"-1.5", 0, "-1"},
-		{"-1.8", 0, "-1"},
+		{"0.00", 1, "0.0"},
+		{"1.20", 1, "1.2"},
+		{"1.21", 1, "1.3"},
+		{"-1.21", 1, "-1.3"},
+		{"1.2345", -1, "2"},
+		{"-0.0000000000000000001", 0, "-1"},
+		{"1.23", 5, "1.23"},
 	}
 	for _, tt := range tests {
 		d := MustParse(tt.d)
-		got := d.Trunc(tt.scale)
+		got := d.RoundAway(tt.scale)
 		want := MustParse(tt.want)
 		if got != want {
-			t.Errorf("%q.Trunc(%v) = %q, want %q", d, tt.scale, got, want)
+			t.Errorf("%q.RoundAway(%v) = %q, want %q", d, tt.scale, got, want)
 		}
 	}
 }
 
-func TestDecimal_Ceil(t *testing.T) {
-	tests := []struct {
-		d     string
-		scale int
-		want  string
-	}{
-		// Zeros
-		{"0", -1, "0"},
-		{"0", 0, "0"},
-		{"0", 1, "0"},
-		{"0", 2, "0"},
+func TestDecimal_RoundSignificant(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			mode RoundingMode
+			want string
+		}{
+			{"0", 3, RoundHalfEven, "0"},
+			{"0.000", 1, RoundHalfEven, "0.000"},
+			{"123.456", 10, RoundHalfEven, "123.456"},
+			{"123.456", 4, RoundHalfEven, "123.5"},
+			{"123.456", 3, RoundHalfEven, "123"},
+			{"123.456", 2, RoundHalfEven, "120"},
+			{"123.456", 0, RoundHalfEven, "100"},
+			{"0.0012345", 3, RoundHalfEven, "0.00123"},
+			{"0.0012345", 4, RoundHalfEven, "0.001234"},
+			{"0.0012345", 4, RoundHalfUp, "0.001235"},
+			{"-0.0012345", 4, RoundFloor, "-0.001235"},
+			{"-0.0012345", 4, RoundCeiling, "-0.001234"},
+			{"9.99", 2, RoundHalfEven, "10.0"},
+			{"99999", 2, RoundHalfEven, "100000"},
+			{"12500", 2, RoundHalfEven, "12000"},
+			{"12500", 2, RoundHalfUp, "13000"},
+			{"-12500", 1, RoundUp, "-20000"},
+			{"9999999999999999999", 1, RoundDown, "9000000000000000000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.RoundSignificant(tt.n, tt.mode)
+			if err != nil {
+				t.Errorf("%q.RoundSignificant(%v, %v) failed: %v", d, tt.n, tt.mode, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.RoundSignificant(%v, %v) = %q, want %q", d, tt.n, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d    string
+			n    int
+			mode RoundingMode
+		}{
+			"overflow 1": {"9999999999999999999", 1, RoundHalfEven},
+			"overflow 2": {"9990000000000000001", 3, RoundUp},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.RoundSignificant(tt.n, tt.mode)
+				if err == nil {
+					t.Errorf("%q.RoundSignificant(%v, %v) did not fail", d, tt.n, tt.mode)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_RoundSignificantFigures(t *testing.T) {
+	tests := []struct {
+		d    string
+		n    int
+		want string
+	}{
+		{"0.0125", 2, "0.012"},
+		{"0.0135", 2, "0.014"},
+		{"2.5", 1, "2"},
+		{"3.5", 1, "4"},
+		{"-2500", 1, "-2000"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.RoundSignificantFigures(tt.n)
+		if err != nil {
+			t.Errorf("%q.RoundSignificantFigures(%v) failed: %v", d, tt.n, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.RoundSignificantFigures(%v) = %q, want %q", d, tt.n, got, want)
+		}
+	}
+}
+
+func TestDecimal_HalfUp(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		{"0", 0, "0"},
+		{"1.234", 2, "1.23"},
+		{"1.235", 2, "1.24"},
+		{"1.245", 2, "1.25"},
+		{"-1.235", 2, "-1.24"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"2.5", -1, "3"},
+		{"2.5", 5, "2.5"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.HalfUp(tt.scale)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.HalfUp(%v) = %q, want %q", d, tt.scale, got, want)
+		}
+	}
+}
+
+func TestDecimal_HalfDown(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		{"0", 0, "0"},
+		{"1.236", 2, "1.24"},
+		{"1.235", 2, "1.23"},
+		{"1.245", 2, "1.24"},
+		{"-1.235", 2, "-1.23"},
+		{"2.5", 0, "2"},
+		{"-2.5", 0, "-2"},
+		{"2.51", 0, "3"},
+		{"2.5", 5, "2.5"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.HalfDown(tt.scale)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.HalfDown(%v) = %q, want %q", d, tt.scale, got, want)
+		}
+	}
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		want string
+	}{
+		{RoundHalfEven, "HalfEven"},
+		{RoundHalfUp, "HalfUp"},
+		{RoundHalfDown, "HalfDown"},
+		{RoundUp, "Up"},
+		{RoundDown, "Down"},
+		{RoundCeiling, "Ceiling"},
+		{RoundFloor, "Floor"},
+		{RoundingMode(100), "RoundingMode(100)"},
+	}
+	for _, tt := range tests {
+		got := tt.mode.String()
+		if got != tt.want {
+			t.Errorf("RoundingMode(%d).String() = %q, want %q", int8(tt.mode), got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Trunc(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		// Zeros
+		{"0", -1, "0"},
+		{"0", 0, "0"},
+		{"0", 1, "0"},
+		{"0", 2, "0"},
+		{"0", 19, "0"},
+		{"0.0", 1, "0.0"},
+		{"0.00", 2, "0.00"},
+		{"0.000000000", 19, "0.000000000"},
+		{"0.000000000", 0, "0"},
+		{"0.000000000", 1, "0.0"},
+		{"0.000000000", 2, "0.00"},
+
+		// Tests from GDA
+		{"2.17", 0, "2"},
+		{"2.17", 1, "2.1"},
+		{"2.17", 2, "2.17"},
+		{"2.17", 9, "2.17"},
+		{"1.2345", 2, "1.23"},
+		{"1.2355", 2, "1.23"},
+		{"1.2345", 9, "1.2345"},
+		{"9.9999", 2, "9.99"},
+		{"0.0001", 2, "0.00"},
+		{"0.001", 2, "0.00"},
+		{"0.009", 2, "0.00"},
+
+		// Some extra tests
+		{"0.03", 2, "0.03"},
+		{"0.02", 2, "0.02"},
+		{"0.01", 2, "0.01"},
+		{"0.00", 2, "0.00"},
+		{"-0.01", 2, "-0.01"},
+		{"-0.02", 2, "-0.02"},
+		{"-0.03", 2, "-0.03"},
+		{"0.0049", 2, "0.00"},
+		{"0.0051", 2, "0.00"},
+		{"0.0149", 2, "0.01"},
+		{"0.0151", 2, "0.01"},
+		{"-0.0049", 2, "0.00"},
+		{"-0.0051", 2, "-0.00"},
+		{"-0.0149", 2, "-0.01"},
+		{"-0.0151", 2, "-0.01"},
+		{"0.0050", 2, "0.00"},
+		{"0.0150", 2, "0.01"},
+		{"0.0250", 2, "0.02"},
+		{"0.0350", 2, "0.03"},
+		{"-0.0050", 2, "0.00"},
+		{"-0.0150", 2, "-0.01"},
+		{"-0.0250", 2, "-0.02"},
+		{"-0.0350", 2, "-0.03"},
+		{"3.0448", 2, "3.04"},
+		{"3.0450", 2, "3.04"},
+		{"3.0452", 2, "3.04"},
+		{"3.0956", 2, "3.09"},
+
+		// Tests from Wikipedia
+		{"1.8", 0, "1"},
+		{"1.5", 0, "1"},
+		{"1.2", 0, "1"},
+		{"0.8", 0, "0"},
+		{"0.5", 0, "0"},
+		{"0.2", 0, "0"},
+		{"-0.2", 0, "0"},
+		{"-0.5", 0, "0"},
+		{"-0.8", 0, "0"},
+		{"-1.2", 0, "-1"},
+		{"-1.5", 0, "-1"},
+		{"-1.8", 0, "-1"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.Trunc(tt.scale)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Trunc(%v) = %q, want %q", d, tt.scale, got, want)
+		}
+	}
+}
+
+func TestDecimal_Ceil(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  string
+	}{
+		// Zeros
+		{"0", -1, "0"},
+		{"0", 0, "0"},
+		{"0", 1, "0"},
+		{"0", 2, "0"},
 		{"0", 19, "0"},
 		{"0.0", 1, "0.0"},
 		{"0.00", 2, "0.00"},
@@ -2360,49 +4042,577 @@ func TestDecimal_Floor(t *testing.T) {
 	}
 	for _, tt := range tests {
 		d := MustParse(tt.d)
-		got := d.Floor(tt.scale)
+		got := d.Floor(tt.scale)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Floor(%v) = %q, want %q", d, tt.scale, got, want)
+		}
+	}
+}
+
+func TestDecimal_FloorToMultiple(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, tick, want string
+		}{
+			{"0", "0.05", "0.00"},
+			{"1.2345", "0.05", "1.20"},
+			{"1.25", "0.05", "1.25"},
+			{"1.2345", "-0.05", "1.20"},
+			{"-1.2345", "0.05", "-1.25"},
+			{"-1.25", "0.05", "-1.25"},
+			{"17", "5", "15"},
+			{"-17", "5", "-20"},
+			{"0.001", "1", "0"},
+			{"-0.001", "1", "-1"},
+			{"123.456", "0.001", "123.456"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			tick := MustParse(tt.tick)
+			got, err := d.FloorToMultiple(tick)
+			if err != nil {
+				t.Errorf("%q.FloorToMultiple(%q) failed: %v", d, tick, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.FloorToMultiple(%q) = %q, want %q", d, tick, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, tick string
+		}{
+			"zero 1":     {"1", "0"},
+			"overflow 1": {"-9999999999999999999", "10"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			tick := MustParse(tt.tick)
+			_, err := d.FloorToMultiple(tick)
+			if err == nil {
+				t.Errorf("%q.FloorToMultiple(%q) did not fail", d, tick)
+			}
+		}
+	})
+}
+
+func TestDecimal_Bucket(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, size, want string
+		}{
+			{"0", "10", "0"},
+			{"9.99", "10", "0"},
+			{"10", "10", "10"},
+			{"25000", "10000", "20000"},
+			{"-0.01", "10", "-10"},
+			{"-10", "10", "-10"},
+			{"1.2345", "0.25", "1.00"},
+			{"1.75", "0.25", "1.75"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			size := MustParse(tt.size)
+			got, err := d.Bucket(size)
+			if err != nil {
+				t.Errorf("%q.Bucket(%q) failed: %v", d, size, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Bucket(%q) = %q, want %q", d, size, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, size string
+		}{
+			"zero 1":     {"1", "0"},
+			"negative 1": {"1", "-10"},
+			"overflow 1": {"-9999999999999999999", "10"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			size := MustParse(tt.size)
+			_, err := d.Bucket(size)
+			if err == nil {
+				t.Errorf("%q.Bucket(%q) did not fail", d, size)
+			}
+		}
+	})
+}
+
+func TestDecimal_CeilToMultiple(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, tick, want string
+		}{
+			{"0", "0.05", "0.00"},
+			{"1.2345", "0.05", "1.25"},
+			{"1.25", "0.05", "1.25"},
+			{"1.2345", "-0.05", "1.25"},
+			{"-1.2345", "0.05", "-1.20"},
+			{"-1.25", "0.05", "-1.25"},
+			{"17", "5", "20"},
+			{"-17", "5", "-15"},
+			{"0.001", "1", "1"},
+			{"-0.001", "1", "0"},
+			{"123.456", "0.001", "123.456"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			tick := MustParse(tt.tick)
+			got, err := d.CeilToMultiple(tick)
+			if err != nil {
+				t.Errorf("%q.CeilToMultiple(%q) failed: %v", d, tick, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.CeilToMultiple(%q) = %q, want %q", d, tick, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, tick string
+		}{
+			"zero 1":     {"1", "0"},
+			"overflow 1": {"9999999999999999999", "10"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			tick := MustParse(tt.tick)
+			_, err := d.CeilToMultiple(tick)
+			if err == nil {
+				t.Errorf("%q.CeilToMultiple(%q) did not fail", d, tick)
+			}
+		}
+	})
+}
+
+func TestDecimal_Snap(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, tick string
+			mode    RoundingMode
+			want    string
+		}{
+			{"1.234", "0.05", RoundHalfEven, "1.25"},
+			{"1.234", "0.05", RoundFloor, "1.20"},
+			{"1.234", "0.05", RoundCeiling, "1.25"},
+			{"1.234", "-0.05", RoundDown, "1.20"},
+			{"-1.234", "0.05", RoundDown, "-1.20"},
+			{"-1.234", "0.05", RoundUp, "-1.25"},
+			{"-1.234", "0.05", RoundFloor, "-1.25"},
+			{"-1.234", "0.05", RoundCeiling, "-1.20"},
+			{"1.25", "0.5", RoundHalfEven, "1.0"},
+			{"1.75", "0.5", RoundHalfEven, "2.0"},
+			{"-1.25", "0.5", RoundHalfEven, "-1.0"},
+			{"1.25", "0.5", RoundHalfUp, "1.5"},
+			{"-1.25", "0.5", RoundHalfUp, "-1.5"},
+			{"1.25", "0.5", RoundHalfDown, "1.0"},
+			{"1.26", "0.5", RoundHalfDown, "1.5"},
+			{"1.5", "0.5", RoundUp, "1.5"},
+			{"0", "0.25", RoundUp, "0.00"},
+			{"0.1", "0.25", RoundUp, "0.25"},
+			{"-0.1", "0.25", RoundDown, "0.00"},
+			{"7", "3", RoundHalfEven, "6"},
+			{"7.5", "3", RoundHalfEven, "6"},
+			{"7.5", "3", RoundHalfUp, "9"},
+			{"4999999999999999999", "9999999999999999999", RoundHalfUp, "0"},
+			{"5000000000000000000", "9999999999999999999", RoundHalfUp, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			tick := MustParse(tt.tick)
+			got, err := d.Snap(tick, tt.mode)
+			if err != nil {
+				t.Errorf("%q.Snap(%q, %v) failed: %v", d, tick, tt.mode, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Snap(%q, %v) = %q, want %q", d, tick, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, tick string
+			mode    RoundingMode
+		}{
+			"zero 1":     {"1", "0", RoundHalfEven},
+			"overflow 1": {"9999999999999999999", "2", RoundUp},
+			"overflow 2": {"-9999999999999999999", "10", RoundFloor},
+			"overflow 3": {"9999999999999999999", "0.0000000000000000001", RoundHalfEven},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				tick := MustParse(tt.tick)
+				_, err := d.Snap(tick, tt.mode)
+				if err == nil {
+					t.Errorf("%q.Snap(%q, %v) did not fail", d, tick, tt.mode)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_IsMultipleOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e string
+			want bool
+		}{
+			{"0", "1", true},
+			{"0.00", "0.05", true},
+			{"0.15", "0.05", true},
+			{"0.16", "0.05", false},
+			{"-0.15", "0.05", true},
+			{"0.15", "-0.05", true},
+			{"1", "0.0000000000000000001", true},
+			{"9999999999999999999", "0.0000000000000000001", true},
+			{"9999999999999999999", "3", true},
+			{"9999999999999999999", "2", false},
+			{"0.0000000000000000001", "1", false},
+			{"1.50", "0.5", true},
+			{"1.5", "0.50", true},
+			{"2.5", "1.25", true},
+			{"2.5", "1.3", false},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.IsMultipleOf(e)
+			if err != nil {
+				t.Errorf("%q.IsMultipleOf(%q) failed: %v", d, e, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.IsMultipleOf(%q) = %v, want %v", d, e, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"zero 1": {"1", "0"},
+			"zero 2": {"0", "0.00"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.IsMultipleOf(e)
+				if err == nil {
+					t.Errorf("%q.IsMultipleOf(%q) did not fail", d, e)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_ScaleInRange(t *testing.T) {
+	tests := []struct {
+		d      string
+		lo, hi int
+		want   bool
+	}{
+		{"0", 0, 0, true},
+		{"0.00", 0, 8, true},
+		{"1.23", 2, 2, true},
+		{"1.23", 3, 8, false},
+		{"1.230", 0, 2, false},
+		{"1.12345678", 0, 8, true},
+		{"1.123456789", 0, 8, false},
+		{"1.23", 3, 1, false},
+		{"1.23", -1, 19, true},
+		{"0.0000000000000000001", 19, 19, true},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.ScaleInRange(tt.lo, tt.hi)
+		if got != tt.want {
+			t.Errorf("%q.ScaleInRange(%v, %v) = %v, want %v", d, tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Exponent(t *testing.T) {
+	tests := []struct {
+		d    string
+		want int
+	}{
+		{"0", 0},
+		{"0.00", -2},
+		{"1.23", -2},
+		{"-1.23", -2},
+		{"123", 0},
+		{"1.000", -3},
+		{"0.0000000000000000001", -19},
+		{"9999999999999999999", 0},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.Exponent()
+		if got != tt.want {
+			t.Errorf("%q.Exponent() = %v, want %v", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_MinScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			want int
+		}{
+			{"0", 0},
+			{"0.0", 0},
+			{"1", 0},
+			{"1.000000000", 0},
+			{"0.100000000", 1},
+			{"0.010000000", 2},
+			{"0.001000000", 3},
+			{"0.000100000", 4},
+			{"0.000010000", 5},
+			{"0.000001000", 6},
+			{"0.000000100", 7},
+			{"0.000000010", 8},
+			{"0.000000001", 9},
+			{"0.000000000", 0},
+			{"0.0000000000000000000", 0},
+			{"0.1000000000000000000", 1},
+			{"0.0000000000000000001", 19},
+			{"0.9999999999999999999", 19},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got := d.MinScale()
+			if got != tt.want {
+				t.Errorf("%q.MinScale() = %v, want %v", d, got, tt.want)
+			}
+		}
+	})
+}
+
+func TestDecimal_IsRepresentableAs(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		want  bool
+	}{
+		{"0", 0, true},
+		{"0", 19, true},
+		{"0.000", 0, true},
+		{"1.50", 1, true},
+		{"1.50", 0, false},
+		{"1.501", 2, false},
+		{"1.501", 3, true},
+		{"-1.501", 3, true},
+		{"1.501", 19, false},
+		{"1.501", 18, true},
+		{"9999999999999999999", 0, true},
+		{"9999999999999999999", 1, false},
+		{"0.0000000000000000001", 19, true},
+		{"0.0000000000000000001", 18, false},
+		{"1", -1, false},
+		{"1", 20, false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.IsRepresentableAs(tt.scale)
+		if got != tt.want {
+			t.Errorf("%q.IsRepresentableAs(%v) = %v, want %v", d, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Coerce(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"0", 2, "0.00"},
+			{"0.000", 2, "0.00"},
+			{"1.2", 4, "1.2000"},
+			{"1.2345", 2, "1.23"},
+			{"-1.2399", 2, "-1.23"},
+			{"1.23", 2, "1.23"},
+			{"1.99", 0, "1"},
+			{"0.1", 19, "0.1000000000000000000"},
+			{"999999999.5", 10, "999999999.5000000000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Coerce(tt.scale)
+			if err != nil {
+				t.Errorf("%q.Coerce(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Coerce(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     string
+			scale int
+		}{
+			"scale 1":    {"1", -1},
+			"scale 2":    {"1", 20},
+			"overflow 1": {"1", 19},
+			"overflow 2": {"9999999999.5", 10},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.Coerce(tt.scale)
+				if err == nil {
+					t.Errorf("%q.Coerce(%v) did not fail", d, tt.scale)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_SetScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"0", 2, "0.00"},
+			{"0.000", 0, "0"},
+			{"1.2", 4, "1.2000"},
+			{"1.2300", 2, "1.23"},
+			{"-1.2300", 3, "-1.230"},
+			{"1.23", 2, "1.23"},
+			{"1.00", 0, "1"},
+			{"0.1", 19, "0.1000000000000000000"},
+			{"999999999.5", 10, "999999999.5000000000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.SetScale(tt.scale)
+			if err != nil {
+				t.Errorf("%q.SetScale(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.SetScale(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     string
+			scale int
+			loss  bool
+		}{
+			"scale 1":    {"1", -1, false},
+			"scale 2":    {"1", 20, false},
+			"loss 1":     {"1.2345", 2, true},
+			"loss 2":     {"-0.0000000000000000001", 18, true},
+			"overflow 1": {"10", 19, false},
+			"overflow 2": {"9999999999.5", 10, false},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.SetScale(tt.scale)
+				if err == nil {
+					t.Errorf("%q.SetScale(%v) did not fail", d, tt.scale)
+					return
+				}
+				if got := errors.Is(err, ErrPrecisionLoss); got != tt.loss {
+					t.Errorf("errors.Is(%q.SetScale(%v), ErrPrecisionLoss) = %v, want %v", d, tt.scale, got, tt.loss)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_TruncateTo(t *testing.T) {
+	tests := []struct {
+		d      string
+		places int
+		want   string
+	}{
+		{"0", -2, "0"},
+		{"0.00", -2, "0"},
+		{"1234.5", 2, "1234.5"},
+		{"1234.5", 1, "1234.5"},
+		{"1234.5", 0, "1234"},
+		{"1234.5", -1, "1230"},
+		{"1234.5", -2, "1200"},
+		{"1234.5", -3, "1000"},
+		{"1234.5", -4, "0"},
+		{"-1234.5", -2, "-1200"},
+		{"-99", -2, "0"},
+		{"1.2345", 2, "1.23"},
+		{"-1.2345", 2, "-1.23"},
+		{"9999999999999999999", -18, "9000000000000000000"},
+		{"9999999999999999999", -19, "0"},
+		{"9999999999999999999", -100, "0"},
+		{"0.9999999999999999999", -1, "0"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.TruncateTo(tt.places)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.TruncateTo(%v) = %q, want %q", d, tt.places, got, want)
+		}
+	}
+}
+
+func TestDecimal_DropIntegerPart(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0.00"},
+		{"1", "0"},
+		{"1.00", "0.00"},
+		{"123.456", "0.456"},
+		{"-123.456", "-0.456"},
+		{"-1.5", "-0.5"},
+		{"-2.0", "0.0"},
+		{"0.9999999999999999999", "0.9999999999999999999"},
+		{"9999999999999999999", "0"},
+		{"999999999.9999999999", "0.9999999999"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.DropIntegerPart()
 		want := MustParse(tt.want)
 		if got != want {
-			t.Errorf("%q.Floor(%v) = %q, want %q", d, tt.scale, got, want)
+			t.Errorf("%q.DropIntegerPart() = %q, want %q", d, got, want)
 		}
 	}
 }
 
-func TestDecimal_MinScale(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
-		tests := []struct {
-			d    string
-			want int
-		}{
-			{"0", 0},
-			{"0.0", 0},
-			{"1", 0},
-			{"1.000000000", 0},
-			{"0.100000000", 1},
-			{"0.010000000", 2},
-			{"0.001000000", 3},
-			{"0.000100000", 4},
-			{"0.000010000", 5},
-			{"0.000001000", 6},
-			{"0.000000100", 7},
-			{"0.000000010", 8},
-			{"0.000000001", 9},
-			{"0.000000000", 0},
-			{"0.0000000000000000000", 0},
-			{"0.1000000000000000000", 1},
-			{"0.0000000000000000001", 19},
-			{"0.9999999999999999999", 19},
-		}
-		for _, tt := range tests {
-			d := MustParse(tt.d)
-			got := d.MinScale()
-			if got != tt.want {
-				t.Errorf("%q.MinScale() = %v, want %v", d, got, tt.want)
-			}
-		}
-	})
-}
-
 func TestDecimal_Trim(t *testing.T) {
 	tests := []struct {
 		d     string
@@ -2431,6 +4641,185 @@ func TestDecimal_Trim(t *testing.T) {
 	}
 }
 
+func TestDecimal_Sin(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+		{"0.5", "0.4794255386042030003"},
+		{"1", "0.8414709848078965067"},
+		{"-1", "-0.8414709848078965067"},
+		{"2", "0.9092974268256816954"},
+		{"-4", "0.7568024953079282514"},
+		{"100", "-0.5063656411097587937"},
+		{"1000000", "-0.3499935021712929521"},
+		{"9999999999999999999", "-0.1854225440700899530"},
+		{"3.141592653589793238", "0.0000000000000000005"},
+		{"4.712388980384689858", "-1.000000000000000000"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Sin()
+		if err != nil {
+			t.Errorf("%q.Sin() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Sin() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Cos(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"0", "1"},
+		{"0.00", "1"},
+		{"0.0000000000000000001", "1.000000000000000000"},
+		{"0.5", "0.8775825618903727161"},
+		{"2", "-0.4161468365471423870"},
+		{"-4", "-0.6536436208636119146"},
+		{"11", "0.0044256979880507857"},
+		{"100", "0.8623188722876839341"},
+		{"1000000", "0.9367521275331447869"},
+		{"9999999999999999999", "-0.9826588829042230508"},
+		{"3.141592653589793238", "-1.000000000000000000"},
+		{"1.570796326794896619", "0.0000000000000000002"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got, err := d.Cos()
+		if err != nil {
+			t.Errorf("%q.Cos() failed: %v", d, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Cos() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Tan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.5", "0.5463024898437905133"},
+			{"2", "-2.185039863261518992"},
+			{"11", "-225.9508464541951420"},
+			{"-0.7853981633974483096", "-1.000000000000000000"},
+			{"3.141592653589793238", "-0.0000000000000000005"},
+			{"4.712388980384689858", "-3267600911027247505"},
+			{"9999999999999999999", "0.1886947213279936917"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Tan()
+			if err != nil {
+				t.Errorf("%q.Tan() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Tan() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "20.42035224833365605",
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt)
+				_, err := d.Tan()
+				if err == nil {
+					t.Errorf("%q.Tan() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestNormalPDF(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "0.3989422804014326779"},
+		{"0.0000000000000000001", "0.3989422804014326779"},
+		{"0.5", "0.3520653267642994778"},
+		{"-0.5", "0.3520653267642994778"},
+		{"1", "0.2419707245191433498"},
+		{"1.96", "0.0584409443334514603"},
+		{"3", "0.0044318484119380072"},
+		{"5", "0.0000014867195147343"},
+		{"-8", "0.0000000000000050523"},
+		{"10", "0.0000000000000000000"},
+		{"-10.1", "0.0000000000000000000"},
+		{"9999999999999999999", "0.0000000000000000000"},
+	}
+	for _, tt := range tests {
+		x := MustParse(tt.x)
+		got, err := NormalPDF(x)
+		if err != nil {
+			t.Errorf("NormalPDF(%q) failed: %v", x, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("NormalPDF(%q) = %q, want %q", x, got, want)
+		}
+	}
+}
+
+func TestNormalCDF(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "0.5000000000000000000"},
+		{"0.5", "0.6914624612740131036"},
+		{"-0.5", "0.3085375387259868964"},
+		{"1", "0.8413447460685429486"},
+		{"-1", "0.1586552539314570514"},
+		{"1.96", "0.9750021048517795659"},
+		{"-1.96", "0.0249978951482204341"},
+		{"3", "0.9986501019683699055"},
+		{"-5", "0.0000002866515718792"},
+		{"8", "0.9999999999999993779"},
+		{"-8", "0.0000000000000006221"},
+		{"-6.58", "0.0000000000235224134"},
+		{"-6.92", "0.0000000000022582182"},
+		{"-7.94", "0.0000000000000010109"},
+		{"-8.65", "0.0000000000000000026"},
+		{"-8.99", "0.0000000000000000001"},
+		{"-9.67", "0.0000000000000000000"},
+		{"10", "1.000000000000000000"},
+		{"-10", "0.0000000000000000000"},
+		{"10.1", "1"},
+		{"-9999999999999999999", "0.0000000000000000000"},
+	}
+	for _, tt := range tests {
+		x := MustParse(tt.x)
+		got, err := NormalCDF(x)
+		if err != nil {
+			t.Errorf("NormalCDF(%q) failed: %v", x, err)
+			continue
+		}
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("NormalCDF(%q) = %q, want %q", x, got, want)
+		}
+	}
+}
+
 func TestSum(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -2531,6 +4920,142 @@ func TestSum(t *testing.T) {
 	})
 }
 
+func TestSumWithScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     []string
+			scale int
+			want  string
+		}{
+			{[]string{"0"}, 2, "0.00"},
+			{[]string{"1"}, 0, "1"},
+			{[]string{"1.005", "1.005"}, 2, "2.01"},
+			{[]string{"0.125", "0.0001"}, 2, "0.13"},
+			{[]string{"0.125", "0"}, 2, "0.12"},
+			{[]string{"5.75", "3.3"}, 4, "9.0500"},
+			{[]string{"5", "-3.001"}, 2, "2.00"},
+			{[]string{"-5", "-3.005"}, 2, "-8.00"},
+			{[]string{"-5", "-3.015"}, 2, "-8.02"},
+			{[]string{"1", "2", "3"}, 18, "6.000000000000000000"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000004"}, 18, "0.000000000000000000"},
+			{[]string{"9999999999999999999", "-1"}, 0, "9999999999999999998"},
+			{[]string{"9999999999999999999", "0.4"}, 0, "9999999999999999999"},
+
+			// No double rounding
+			{[]string{"99999999999999999.3", "0.049"}, 1, "99999999999999999.3"},
+		}
+		for _, tt := range tests {
+			d := make([]Decimal, len(tt.d))
+			for i, s := range tt.d {
+				d[i] = MustParse(s)
+			}
+			got, err := SumWithScale(tt.scale, d...)
+			if err != nil {
+				t.Errorf("SumWithScale(%v, %v) failed: %v", tt.scale, d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("SumWithScale(%v, %v) = %q, want %q", tt.scale, d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     []string
+			scale int
+		}{
+			"no arguments": {[]string{}, 2},
+			"scale 1":      {[]string{"1"}, -1},
+			"scale 2":      {[]string{"1"}, 20},
+			"overflow 1":   {[]string{"9999999999999999999", "1"}, 0},
+			"overflow 2":   {[]string{"9999999999999999999", "0.5"}, 0},
+			"overflow 3":   {[]string{"999999999999999999", "1"}, 1},
+			"overflow 4":   {[]string{"1", "1"}, 19},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := make([]Decimal, len(tt.d))
+				for i, s := range tt.d {
+					d[i] = MustParse(s)
+				}
+				_, err := SumWithScale(tt.scale, d...)
+				if err == nil {
+					t.Errorf("SumWithScale(%v, %v) did not fail", tt.scale, d)
+				}
+			})
+		}
+	})
+}
+
+func TestRunningTotal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			add, sub []string
+			want     string
+		}{
+			{nil, nil, "0"},
+			{[]string{"1.5", "2.25"}, nil, "3.75"},
+			{[]string{"1"}, []string{"1.00"}, "0.00"},
+			{[]string{"1"}, []string{"3"}, "-2"},
+			{[]string{"-1.1"}, []string{"-2.2"}, "1.1"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000009"}, nil, "0.0000000000000000010"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, []string{"9999999999999999999"}, "9999999999999999999"},
+			{[]string{"9999999999999999999", "0.0000000000000000001"}, []string{"0.0000000000000000001"}, "9999999999999999999"},
+			{[]string{"9999999999999999999", "0.9999999999999999999"}, []string{"9999999999999999999"}, "0.9999999999999999999"},
+			{[]string{"999999999999999999", "0.6"}, nil, "999999999999999999.6"},
+		}
+		for _, tt := range tests {
+			var total RunningTotal
+			for _, s := range tt.add {
+				total.Add(MustParse(s))
+			}
+			for _, s := range tt.sub {
+				total.Sub(MustParse(s))
+			}
+			got, err := total.Total()
+			if err != nil {
+				t.Errorf("RunningTotal(+%v, -%v).Total() failed: %v", tt.add, tt.sub, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("RunningTotal(+%v, -%v).Total() = %q, want %q", tt.add, tt.sub, got, want)
+			}
+		}
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		var total RunningTotal
+		total.Sub(MustParse("9999999999999999999"))
+		total.Sub(MustParse("9999999999999999999"))
+		total.Sub(MustParse("0.01"))
+		if _, err := total.Total(); err == nil {
+			t.Errorf("RunningTotal.Total() did not fail")
+		}
+		total.Reset()
+		total.Add(MustParse("1"))
+		got, err := total.Total()
+		if err != nil {
+			t.Fatalf("RunningTotal.Total() failed: %v", err)
+		}
+		want := MustParse("1")
+		if got != want {
+			t.Errorf("RunningTotal.Total() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var total RunningTotal
+		total.Add(MustParse("9999999999999999999"))
+		total.Add(MustParse("1"))
+		if _, err := total.Total(); err == nil {
+			t.Errorf("RunningTotal.Total() did not fail")
+		}
+	})
+}
+
 func TestDecimal_Add(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -2658,32 +5183,122 @@ func TestDecimal_Sub(t *testing.T) {
 	})
 }
 
-func TestDecimal_SubAbs(t *testing.T) {
+func TestDecimal_SubAbs(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			// Signs
+			{"5", "3", "2"},
+			{"3", "5", "2"},
+			{"-5", "-3", "2"},
+			{"-3", "-5", "2"},
+			{"-5", "3", "8"},
+			{"-3", "5", "8"},
+			{"5", "-3", "8"},
+			{"3", "-5", "8"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.SubAbs(e)
+			if err != nil {
+				t.Errorf("%q.SubAbs(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.SubAbs(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"overflow 1": {"1", "-9999999999999999999"},
+			"overflow 2": {"9999999999999999999", "-1"},
+			"overflow 3": {"9999999999999999999", "-9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			_, err := d.SubAbs(e)
+			if err == nil {
+				t.Errorf("%q.SubAbs(%q) did not fail", d, e)
+			}
+		}
+	})
+}
+
+func TestDecimal_AddStrict(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"5", "3", "8"},
+			{"-5", "3", "-2"},
+			{"1.5", "0.25", "1.75"},
+			{"9999999999999999998", "1", "9999999999999999999"},
+			{"100000000", "0.0000000001", "100000000.0000000001"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.AddStrict(e)
+			if err != nil {
+				t.Errorf("%q.AddStrict(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.AddStrict(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"overflow 1": {"9999999999999999999", "1"},
+			"overflow 2": {"10000000000", "0.0000000001"},
+			"overflow 3": {"0.0000000001", "10000000000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			_, err := d.AddStrict(e)
+			if err == nil {
+				t.Errorf("%q.AddStrict(%q) did not fail", d, e)
+			}
+		}
+	})
+}
+
+func TestDecimal_SubStrict(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			d, e, want string
 		}{
-			// Signs
 			{"5", "3", "2"},
-			{"3", "5", "2"},
-			{"-5", "-3", "2"},
-			{"-3", "-5", "2"},
-			{"-5", "3", "8"},
-			{"-3", "5", "8"},
-			{"5", "-3", "8"},
-			{"3", "-5", "8"},
+			{"3", "5", "-2"},
+			{"-5", "-3", "-2"},
+			{"1.5", "0.25", "1.25"},
+			{"-9999999999999999998", "1", "-9999999999999999999"},
 		}
 		for _, tt := range tests {
 			d := MustParse(tt.d)
 			e := MustParse(tt.e)
-			got, err := d.SubAbs(e)
+			got, err := d.SubStrict(e)
 			if err != nil {
-				t.Errorf("%q.SubAbs(%q) failed: %v", d, e, err)
+				t.Errorf("%q.SubStrict(%q) failed: %v", d, e, err)
 				continue
 			}
 			want := MustParse(tt.want)
 			if got != want {
-				t.Errorf("%q.SubAbs(%q) = %q, want %q", d, e, got, want)
+				t.Errorf("%q.SubStrict(%q) = %q, want %q", d, e, got, want)
 			}
 		}
 	})
@@ -2692,16 +5307,15 @@ func TestDecimal_SubAbs(t *testing.T) {
 		tests := map[string]struct {
 			d, e string
 		}{
-			"overflow 1": {"1", "-9999999999999999999"},
-			"overflow 2": {"9999999999999999999", "-1"},
-			"overflow 3": {"9999999999999999999", "-9999999999999999999"},
+			"overflow 1": {"-9999999999999999999", "1"},
+			"overflow 2": {"10000000000", "0.0000000001"},
 		}
 		for _, tt := range tests {
 			d := MustParse(tt.d)
 			e := MustParse(tt.e)
-			_, err := d.SubAbs(e)
+			_, err := d.SubStrict(e)
 			if err == nil {
-				t.Errorf("%q.SubAbs(%q) did not fail", d, e)
+				t.Errorf("%q.SubStrict(%q) did not fail", d, e)
 			}
 		}
 	})
@@ -2966,6 +5580,166 @@ func TestDecimal_Mul(t *testing.T) {
 	})
 }
 
+func TestDecimal_MulInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			want string
+		}{
+			{"0", 5, "0"},
+			{"1.23", 0, "0.00"},
+			{"1.23", 3, "3.69"},
+			{"1.23", -3, "-3.69"},
+			{"-1.23", -3, "3.69"},
+			{"0.0000000000000000001", 10, "0.0000000000000000010"},
+			{"1", math.MinInt64, "-9223372036854775808"},
+			{"0.5", math.MaxInt64, "4611686018427387903.5"},
+			{"999999999999999999.9", 10, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.MulInt(tt.n)
+			if err != nil {
+				t.Errorf("%q.MulInt(%v) failed: %v", d, tt.n, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.MulInt(%v) = %q, want %q", d, tt.n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d string
+			n int
+		}{
+			"overflow 1": {"9999999999999999999", 2},
+			"overflow 2": {"-2", math.MaxInt64},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.MulInt(tt.n)
+				if err == nil {
+					t.Errorf("%q.MulInt(%v) did not fail", d, tt.n)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_MulRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e  string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			// Zeros
+			{"0", "3", 2, RoundUp, "0.00"},
+			{"0.000", "-3.0", 1, RoundFloor, "0.0"},
+
+			// Modes
+			{"19.99", "0.075", 2, RoundHalfEven, "1.50"},
+			{"19.99", "0.075", 2, RoundHalfUp, "1.50"},
+			{"1.00", "0.125", 2, RoundHalfEven, "0.12"},
+			{"1.00", "0.125", 2, RoundHalfUp, "0.13"},
+			{"1.00", "0.125", 2, RoundHalfDown, "0.12"},
+			{"-1.00", "0.125", 2, RoundHalfUp, "-0.13"},
+			{"3", "0.125", 2, RoundHalfDown, "0.37"},
+			{"1.11", "1.11", 2, RoundUp, "1.24"},
+			{"1.11", "1.11", 2, RoundDown, "1.23"},
+			{"-1.11", "1.11", 2, RoundCeiling, "-1.23"},
+			{"-1.11", "1.11", 2, RoundFloor, "-1.24"},
+			{"1.11", "1.11", 2, RoundCeiling, "1.24"},
+			{"1.11", "1.11", 2, RoundFloor, "1.23"},
+
+			// Scales
+			{"2", "3", 4, RoundHalfEven, "6.0000"},
+			{"1.5", "1.5", 0, RoundHalfEven, "2"},
+			{"0.0000000001", "0.0000000001", 19, RoundHalfEven, "0.0000000000000000000"},
+			{"0.0000000001", "0.0000000001", 19, RoundUp, "0.0000000000000000001"},
+			{"9999999999999999999", "0.1", 0, RoundHalfEven, "1000000000000000000"},
+			{"9999999999999999999", "0.9999999999999999999", 0, RoundHalfEven, "9999999999999999998"},
+			{"9999999999999999999", "0.9999999999999999999", 0, RoundUp, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.MulRound(e, tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("%q.MulRound(%q, %v, %v) failed: %v", d, e, tt.scale, tt.mode, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.MulRound(%q, %v, %v) = %q, want %q", d, e, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e  string
+			scale int
+		}{
+			"scale 1":    {"1", "3", -1},
+			"scale 2":    {"1", "3", 20},
+			"overflow 1": {"9999999999999999999", "10", 0},
+			"overflow 2": {"9999999999999999999", "1", 1},
+			"overflow 3": {"1", "1", 19},
+			"overflow 4": {"9999999999999999999", "1.5", 0},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.MulRound(e, tt.scale, RoundHalfEven)
+				if err == nil {
+					t.Errorf("%q.MulRound(%q, %v, %v) did not fail", d, e, tt.scale, RoundHalfEven)
+				}
+			})
+		}
+	})
+}
+
+func TestErrPrecisionLoss(t *testing.T) {
+	t.Run("wrapped", func(t *testing.T) {
+		_, err := MustParse("1.23").SetScale(1)
+		if !errors.Is(err, ErrPrecisionLoss) {
+			t.Errorf("SetScale(...) = %v, want %v", err, ErrPrecisionLoss)
+		}
+	})
+
+	t.Run("not wrapped", func(t *testing.T) {
+		tests := map[string]func() (Decimal, error){
+			"Mul":        func() (Decimal, error) { return MustParse("9999999999999999999").Mul(Ten) },
+			"MulExact":   func() (Decimal, error) { return MustParse("99999999999999999").MulExact(Ten, 2) },
+			"AddExact":   func() (Decimal, error) { return MustParse("99999999999999999").AddExact(One, 2) },
+			"QuoExact":   func() (Decimal, error) { return MustParse("1").QuoExact(MustParse("0.001"), 17) },
+			"ParseExact": func() (Decimal, error) { return ParseExact("1000000000000000000", 1) },
+			"Succ":       func() (Decimal, error) { return MustParse("0.9999999999999999999").Succ() },
+			"SetScale":   func() (Decimal, error) { return MustParse("10").SetScale(19) },
+			"Coerce":     func() (Decimal, error) { return MustParse("10").Coerce(19) },
+		}
+		for name, f := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := f()
+				if err == nil {
+					t.Fatalf("%v(...) did not fail", name)
+				}
+				if errors.Is(err, ErrPrecisionLoss) {
+					t.Errorf("%v(...) = %v, want no %v", name, err, ErrPrecisionLoss)
+				}
+			})
+		}
+	})
+}
+
 func TestDecimal_AddMul(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -3114,6 +5888,55 @@ func TestDecimal_AddMul(t *testing.T) {
 	})
 }
 
+func TestDecimal_Interpolate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, t, want string
+		}{
+			{"1", "3", "0", "1"},
+			{"1", "3", "1", "3"},
+			{"1", "3", "0.5", "2.0"},
+			{"1", "3", "2", "5"},
+			{"1", "3", "-1", "-1"},
+			{"3", "1", "0.5", "2.0"},
+			{"100.00", "101.00", "0.25", "100.2500"},
+			{"-1", "1", "0.75", "0.50"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			f := MustParse(tt.t)
+			got, err := d.Interpolate(e, f)
+			if err != nil {
+				t.Errorf("%q.Interpolate(%q, %q) failed: %v", d, e, f, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Interpolate(%q, %q) = %q, want %q", d, e, f, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e, t string
+		}{
+			"overflow 1": {"-9999999999999999999", "9999999999999999999", "0.5"},
+			"overflow 2": {"0", "9999999999999999999", "10"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			f := MustParse(tt.t)
+			_, err := d.Interpolate(e, f)
+			if err == nil {
+				t.Errorf("%q.Interpolate(%q, %q) did not fail", d, e, f)
+			}
+		}
+	})
+}
+
 func TestDecimal_AddQuo(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -3988,6 +6811,131 @@ func TestDecimal_PowInt(t *testing.T) {
 	})
 }
 
+func TestDecimal_PowFrac(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d        string
+			num, den int32
+			want     string
+		}{
+			// Roots
+			{"2", 1, 2, "1.414213562373095049"},
+			{"2", 1, 3, "1.259921049894873165"},
+			{"8", 1, 3, "2.000000000000000000"},
+			{"0.001", 1, 3, "0.1000000000000000000"},
+			{"0.0000000000000000001", 1, 19, "0.1000000000000000000"},
+			{"2", 1, 1000000, "1.000000693147420787"},
+			{"2", 1, math.MaxInt32, "1.000000000322771809"},
+			{"9999999999999999999", 1, 2, "3162277660.168379332"},
+
+			// Rational powers
+			{"27", 2, 3, "9.000000000000000000"},
+			{"16", 3, 4, "8.000000000000000000"},
+			{"10", 6, 4, "31.62277660168379332"},
+			{"123.456", 7, 5, "847.4558505883076512"},
+			{"9999999999999999999", 2, 3, "4641588833612.778892"},
+			{"4", -1, 2, "0.5000000000000000000"},
+			{"0.5", -5, 3, "3.174802103936398950"},
+			{"0.0000000000000000001", -1, 2, "3162277660.168379332"},
+			{"0.0000000000000000001", 5, 3, "0.0000000000000000000"},
+
+			// Negatives
+			{"-8", 1, 3, "-2.000000000000000000"},
+			{"-8", 2, 3, "4.000000000000000000"},
+			{"-8", 2, 6, "-2.000000000000000000"},
+			{"-2", 3, -5, "-0.6597539553864471297"},
+
+			// Integer powers
+			{"2", 2, 1, "4"},
+			{"2", 4, 2, "4"},
+			{"2", 0, 5, "1"},
+			{"0", 0, 5, "1"},
+			{"0", 1, 3, "0"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.PowFrac(tt.num, tt.den)
+			if err != nil {
+				t.Errorf("%q.PowFrac(%v, %v) failed: %v", d, tt.num, tt.den, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.PowFrac(%v, %v) = %q, want %q", d, tt.num, tt.den, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d        string
+			num, den int32
+		}{
+			"zero denominator": {"2", 1, 0},
+			"zero 1":           {"0", -1, 3},
+			"negative 1":       {"-4", 1, 2},
+			"negative 2":       {"-8", 3, 4},
+			"overflow 1":       {"10", 40, 2},
+			"overflow 2":       {"9999999999999999999", 3, 2},
+			"overflow 3":       {"0.0000000000000000001", -7, 3},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.PowFrac(tt.num, tt.den)
+				if err == nil {
+					t.Errorf("%q.PowFrac(%v, %v) did not fail", d, tt.num, tt.den)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_PowN(t *testing.T) {
+	tests := []struct {
+		d      string
+		n      uint
+		limit  string
+		want   string
+		wantOk bool
+	}{
+		// Full power
+		{"2", 0, "1000", "1", true},
+		{"2", 10, "1024", "1024", true},
+		{"1.5", 3, "10", "3.375", true},
+		{"-2", 3, "10", "-8", true},
+		{"0", 5, "1", "0", true},
+
+		// Limit exceeded
+		{"2", 0, "0.5", "1", false},
+		{"2", 11, "1024", "2048", false},
+		{"-2", 10, "10", "16", false},
+		{"-2", 3, "5", "-8", false},
+		{"-2", 7, "20", "-128", false},
+		{"10", 100, "1000000", "100000000", false},
+		{"2", math.MaxUint, "1000000", "2147483648", false},
+
+		// Large power
+		{"1", math.MaxUint, "1", "1", true},
+		{"0.5", math.MaxUint, "1", "0.0000000000000000000", true},
+		{"-0.5", math.MaxUint, "1", "0.0000000000000000000", true},
+		{"-1", math.MaxUint, "1", "-1", true},
+		{"-1", math.MaxUint - 1, "1", "1", true},
+
+		// Overflow
+		{"10", 100, "9999999999999999999", "10000000000000000", false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		limit := MustParse(tt.limit)
+		got, ok := d.PowN(tt.n, limit)
+		want := MustParse(tt.want)
+		if got != want || ok != tt.wantOk {
+			t.Errorf("%q.PowN(%v, %q) = (%q, %v), want (%q, %v)", d, tt.n, limit, got, ok, want, tt.wantOk)
+		}
+	}
+}
+
 func TestDecimal_Sqrt(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -4100,37 +7048,150 @@ func TestDecimal_Sqrt(t *testing.T) {
 			{"0.0000000000000000001", "0.000000000316227766"},
 			{"9999999999999999999", "3162277660.168379332"},
 
-			// Captured during fuzzing
-			{"1.000000000000000063", "1.000000000000000031"},
-			{"0.000000272", "0.0005215361924162119"},
-			{"0.9999999999999999999", "0.9999999999999999999"},
+			// Captured during fuzzing
+			{"1.000000000000000063", "1.000000000000000031"},
+			{"0.000000272", "0.0005215361924162119"},
+			{"0.9999999999999999999", "0.9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Sqrt()
+			if err != nil {
+				t.Errorf("%q.Sqrt() failed: %v", d, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Sqrt() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"negative": "-1",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(d)
+				_, err := d.Sqrt()
+				if err == nil {
+					t.Errorf("%q.Sqrt() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Hypot(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"0", "0", "0"},
+			{"0.00", "0.0", "0.00"},
+			{"3", "4", "5"},
+			{"-3", "4", "5"},
+			{"0.3", "-0.4", "0.5"},
+			{"0.30", "0.4", "0.50"},
+			{"5", "0", "5"},
+			{"1", "1", "1.414213562373095049"},
+			{"0.0000000000000000001", "0.0000000000000000001", "0.0000000000000000001"},
+			{"0.0000000000000000003", "0.0000000000000000004", "0.0000000000000000005"},
+			{"9999999999999999999", "1", "9999999999999999999"},
+			{"6999999999999999999", "6999999999999999999", "9899494936611665340"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.Hypot(e)
+			if err != nil {
+				t.Errorf("%q.Hypot(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Hypot(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"overflow 1": {"9999999999999999999", "9999999999999999999"},
+			"overflow 2": {"-8000000000000000000", "8000000000000000000"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.Hypot(e)
+				if err == nil {
+					t.Errorf("%q.Hypot(%q) did not fail", d, e)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_ApproxSqrt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"0", "0.00", "1", "2", "0.25", "0.0000000000000000001", "9999999999999999999"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			got, err := d.ApproxSqrt()
+			if err != nil {
+				t.Errorf("%q.ApproxSqrt() failed: %v", d, err)
+				continue
+			}
+			f, _ := d.Float64()
+			want := math.Sqrt(f)
+			if got != want {
+				t.Errorf("%q.ApproxSqrt() = %v, want %v", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"-1", "-0.0000000000000000001"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.ApproxSqrt()
+			if err == nil {
+				t.Errorf("%q.ApproxSqrt() did not fail", d)
+			}
 		}
+	})
+}
+
+func TestDecimal_ApproxLog(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []string{"1", "0.5", "2.718281828459045235", "10", "0.0000000000000000001", "9999999999999999999"}
 		for _, tt := range tests {
-			d := MustParse(tt.d)
-			got, err := d.Sqrt()
+			d := MustParse(tt)
+			got, err := d.ApproxLog()
 			if err != nil {
-				t.Errorf("%q.Sqrt() failed: %v", d, err)
+				t.Errorf("%q.ApproxLog() failed: %v", d, err)
 				continue
 			}
-			want := MustParse(tt.want)
+			f, _ := d.Float64()
+			want := math.Log(f)
 			if got != want {
-				t.Errorf("%q.Sqrt() = %q, want %q", d, got, want)
+				t.Errorf("%q.ApproxLog() = %v, want %v", d, got, want)
 			}
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
-		tests := map[string]string{
-			"negative": "-1",
-		}
-		for name, d := range tests {
-			t.Run(name, func(t *testing.T) {
-				d := MustParse(d)
-				_, err := d.Sqrt()
-				if err == nil {
-					t.Errorf("%q.Sqrt() did not fail", d)
-				}
-			})
+		tests := []string{"0", "0.000", "-1"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := d.ApproxLog()
+			if err == nil {
+				t.Errorf("%q.ApproxLog() did not fail", d)
+			}
 		}
 	})
 }
@@ -4457,6 +7518,19 @@ func TestDecimal_Exp(t *testing.T) {
 	})
 }
 
+func TestDecimal_ApproxExp(t *testing.T) {
+	tests := []string{"0", "1", "-1", "0.5", "2.302585092994045684", "-20", "100", "9999999999999999999"}
+	for _, tt := range tests {
+		d := MustParse(tt)
+		f, _ := d.Float64()
+		got := d.ApproxExp()
+		want := math.Exp(f)
+		if got != want {
+			t.Errorf("%q.ApproxExp() = %v, want %v", d, got, want)
+		}
+	}
+}
+
 func TestDecimal_Expm1(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -5688,6 +8762,31 @@ func TestDecimal_CopySign(t *testing.T) {
 	}
 }
 
+func TestDecimal_WithSign(t *testing.T) {
+	tests := []struct {
+		d    string
+		neg  bool
+		want string
+	}{
+		{"10", false, "10"},
+		{"10", true, "-10"},
+		{"-10", false, "10"},
+		{"-10", true, "-10"},
+		{"1.00", true, "-1.00"},
+		{"0", false, "0"},
+		{"0", true, "0"},
+		{"0.00", true, "0.00"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		got := d.WithSign(tt.neg)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.WithSign(%v) = %q, want %q", d, tt.neg, got, want)
+		}
+	}
+}
+
 func TestDecimal_Neg(t *testing.T) {
 	tests := []struct {
 		d, want string
@@ -5855,6 +8954,138 @@ func TestDecimal_Quo(t *testing.T) {
 	})
 }
 
+func TestDecimal_QuoInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			want string
+		}{
+			{"0", 5, "0"},
+			{"3.69", 3, "1.23"},
+			{"3.69", -3, "-1.23"},
+			{"-3.69", -3, "1.23"},
+			{"1", 3, "0.3333333333333333333"},
+			{"2", 3, "0.6666666666666666667"},
+			{"10.00", 4, "2.50"},
+			{"9223372036854775808", math.MinInt64, "-1"},
+			{"9999999999999999999", 1, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.QuoInt(tt.n)
+			if err != nil {
+				t.Errorf("%q.QuoInt(%v) failed: %v", d, tt.n, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.QuoInt(%v) = %q, want %q", d, tt.n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d string
+			n int
+		}{
+			"zero 1": {"1", 0},
+			"zero 2": {"0", 0},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.QuoInt(tt.n)
+				if err == nil {
+					t.Errorf("%q.QuoInt(%v) did not fail", d, tt.n)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_QuoRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e  string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			// Zeros
+			{"0", "3", 2, RoundUp, "0.00"},
+			{"0.000", "-3", 0, RoundFloor, "0"},
+
+			// Modes
+			{"1", "8", 2, RoundHalfEven, "0.12"},
+			{"1", "8", 2, RoundHalfUp, "0.13"},
+			{"1", "8", 2, RoundHalfDown, "0.12"},
+			{"3", "8", 2, RoundHalfEven, "0.38"},
+			{"3", "8", 2, RoundHalfDown, "0.37"},
+			{"-1", "8", 2, RoundHalfUp, "-0.13"},
+			{"1", "-8", 2, RoundHalfDown, "-0.12"},
+			{"2", "3", 4, RoundDown, "0.6666"},
+			{"2", "3", 4, RoundUp, "0.6667"},
+			{"-2", "3", 4, RoundCeiling, "-0.6666"},
+			{"-2", "3", 4, RoundFloor, "-0.6667"},
+			{"2", "3", 4, RoundCeiling, "0.6667"},
+			{"2", "3", 4, RoundFloor, "0.6666"},
+			{"1", "3", 0, RoundUp, "1"},
+			{"-1", "3", 0, RoundCeiling, "0"},
+
+			// Scales
+			{"1.23456", "1", 2, RoundHalfEven, "1.23"},
+			{"1.23456", "0.001", 0, RoundHalfEven, "1235"},
+			{"10", "4", 5, RoundHalfEven, "2.50000"},
+			{"1", "0.0000000000000000003", 0, RoundHalfEven, "3333333333333333333"},
+			{"9999999999999999999", "3", 0, RoundHalfEven, "3333333333333333333"},
+			{"0.9999999999999999999", "0.9999999999999999999", 18, RoundHalfEven, "1.000000000000000000"},
+			{"1", "7", 19, RoundHalfEven, "0.1428571428571428571"},
+			{"1", "7", 19, RoundUp, "0.1428571428571428572"},
+			{"0.5", "0.0000000000000000001", 0, RoundDown, "5000000000000000000"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			got, err := d.QuoRound(e, tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("%q.QuoRound(%q, %v, %v) failed: %v", d, e, tt.scale, tt.mode, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.QuoRound(%q, %v, %v) = %q, want %q", d, e, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e  string
+			scale int
+		}{
+			"zero 1":     {"1", "0", 2},
+			"scale 1":    {"1", "3", -1},
+			"scale 2":    {"1", "3", 20},
+			"overflow 1": {"9999999999999999999", "0.1", 0},
+			"overflow 2": {"1", "0.1", 19},
+			"overflow 3": {"9999999999999999999", "0.0000000000000000001", 19},
+			"overflow 4": {"9999999999999999999", "1.0000000000000000001", 1},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, err := d.QuoRound(e, tt.scale, RoundHalfEven)
+				if err == nil {
+					t.Errorf("%q.QuoRound(%q, %v, %v) did not fail", d, e, tt.scale, RoundHalfEven)
+				}
+			})
+		}
+	})
+}
+
 func TestDecimal_Inv(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -5898,6 +9129,65 @@ func TestDecimal_Inv(t *testing.T) {
 	})
 }
 
+func TestDecimal_InvRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			want  string
+		}{
+			{"1", 0, "1"},
+			{"1", 2, "1.00"},
+			{"-2", 2, "-0.50"},
+			{"3", 4, "0.3333"},
+			{"-3", 4, "-0.3333"},
+			{"6", 4, "0.1667"},
+			{"8", 2, "0.12"},
+			{"-8", 2, "-0.12"},
+			{"0.0001", 0, "10000"},
+			{"7", 19, "0.1428571428571428571"},
+			{"3", 0, "0"},
+			{"2", 0, "0"},
+			{"0.4", 0, "2"},
+			{"0.000000000000000001", 0, "1000000000000000000"},
+			{"9999999999999999999", 19, "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.InvRound(tt.scale)
+			if err != nil {
+				t.Errorf("%q.InvRound(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.InvRound(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     string
+			scale int
+		}{
+			"zero 1":     {"0", 2},
+			"scale 1":    {"1", -1},
+			"scale 2":    {"1", 20},
+			"overflow 1": {"0.0000000000000000001", 1},
+			"overflow 2": {"0.1", 19},
+			"overflow 3": {"0.0000000000000000001", 0},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			_, err := d.InvRound(tt.scale)
+			if err == nil {
+				t.Errorf("%q.InvRound(%v) did not fail", d, tt.scale)
+			}
+		}
+	})
+}
+
 func TestDecimal_QuoRem(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -6048,6 +9338,127 @@ func TestDecimal_QuoRem(t *testing.T) {
 	})
 }
 
+func TestDecimal_DivMod(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, wantQuo, wantRem string
+		}{
+			// Signs, truncated rather than floored as in Python and Ruby
+			{"7", "2", "3", "1"},
+			{"-7", "2", "-3", "-1"}, // Python: (-4, 1)
+			{"7", "-2", "-3", "1"},  // Python: (-4, -1)
+			{"-7", "-2", "3", "-1"},
+			{"2.4", "1", "2", "0.4"},
+			{"2.4", "-1", "-2", "0.4"},  // Python: (-3, -0.6)
+			{"-2.4", "1", "-2", "-0.4"}, // Python: (-3, 0.6)
+			{"-2.4", "-1", "2", "-0.4"},
+
+			// Exact division
+			{"6", "-2", "-3", "0"},
+			{"-6.00", "2", "-3", "0.00"},
+
+			// Scales
+			{"-1", "0.3", "-3", "-0.1"},
+			{"-0.001", "1", "0", "-0.001"},
+			{"0", "-5", "0", "0"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			e := MustParse(tt.e)
+			gotQuo, gotRem, err := d.DivMod(e)
+			if err != nil {
+				t.Errorf("%q.DivMod(%q) failed: %v", d, e, err)
+				continue
+			}
+			wantQuo := MustParse(tt.wantQuo)
+			wantRem := MustParse(tt.wantRem)
+			if gotQuo != wantQuo || gotRem != wantRem {
+				t.Errorf("%q.DivMod(%q) = (%q, %q), want (%q, %q)", d, e, gotQuo, gotRem, wantQuo, wantRem)
+			}
+			q, r, err := d.QuoRem(e)
+			if err != nil {
+				t.Errorf("%q.QuoRem(%q) failed: %v", d, e, err)
+				continue
+			}
+			if gotQuo != q || gotRem != r {
+				t.Errorf("%q.DivMod(%q) = (%q, %q), but %q.QuoRem(%q) = (%q, %q)", d, e, gotQuo, gotRem, d, e, q, r)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"zero 1":     {"1", "0"},
+			"overflow 1": {"9999999999999999999", "-0.5"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				e := MustParse(tt.e)
+				_, _, err := d.DivMod(e)
+				if err == nil {
+					t.Errorf("%q.DivMod(%q) did not fail", d, e)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_IsApproxEqual(t *testing.T) {
+	tests := []struct {
+		d, e, pct string
+		want      bool
+	}{
+		{"100", "100", "0", true},
+		{"100.00", "100", "0", true},
+		{"100.01", "100", "0.0001", true},
+		{"99.99", "100", "0.0001", true},
+		{"100.0100000000000001", "100", "0.0001", false},
+		{"99.98", "100", "0.0001", false},
+		{"-100.01", "-100", "0.0001", true},
+		{"100", "-100", "1", false},
+		{"100", "-100", "2", true},
+		{"0", "0", "0", true},
+		{"0.0000000000000000001", "0", "1000", false},
+		{"0", "0.0000000000000000001", "1", true},
+		{"9999999999999999999", "-9999999999999999999", "2", true},
+		{"9999999999999999999", "-9999999999999999999", "1.999999999999999999", false},
+		{"100", "100", "-0.1", false},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		pct := MustParse(tt.pct)
+		got := d.IsApproxEqual(e, pct)
+		if got != tt.want {
+			t.Errorf("%q.IsApproxEqual(%q, %q) = %v, want %v", d, e, pct, got, tt.want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	got := []Decimal{
+		MustParse("1.5"),
+		MustParse("-2"),
+		MustParse("0"),
+		MustParse("1.50"),
+		MustParse("-0.1"),
+	}
+	slices.SortStableFunc(got, Compare)
+	want := []Decimal{
+		MustParse("-2"),
+		MustParse("-0.1"),
+		MustParse("0"),
+		MustParse("1.5"),
+		MustParse("1.50"),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("slices.SortStableFunc(..., Compare) = %v, want %v", got, want)
+	}
+}
+
 func TestDecimal_Cmp(t *testing.T) {
 	tests := []struct {
 		d, e string
@@ -6182,17 +9593,69 @@ func TestDecimal_Min(t *testing.T) {
 		{"-0.000", "-0.0", "0.000"},
 		{"-0.0", "-0.000", "0.000"},
 		{"1.23", "1.2300", "1.2300"},
-		{"1.2300", "1.23", "1.2300"},
-		{"-1.23", "-1.2300", "-1.2300"},
-		{"-1.2300", "-1.23", "-1.2300"},
+		{"1.2300", "1.23", "1.2300"},
+		{"-1.23", "-1.2300", "-1.2300"},
+		{"-1.2300", "-1.23", "-1.2300"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		got := d.Min(e)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.Min(%q) = %q, want %q", d, e, got, want)
+		}
+	}
+}
+
+func TestDecimal_MaxAbs(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"-2", "1", "-2"},
+		{"1", "-2", "-2"},
+		{"2", "-1", "2"},
+		{"-1", "2", "2"},
+		{"0", "-1", "-1"},
+		{"0", "0", "0"},
+		{"-1", "1", "1"},
+		{"1", "-1", "1"},
+		{"1.23", "1.2300", "1.23"},
+		{"-1.23", "1.2300", "1.2300"},
+	}
+	for _, tt := range tests {
+		d := MustParse(tt.d)
+		e := MustParse(tt.e)
+		got := d.MaxAbs(e)
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.MaxAbs(%q) = %q, want %q", d, e, got, want)
+		}
+	}
+}
+
+func TestDecimal_MinAbs(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"-2", "1", "1"},
+		{"1", "-2", "1"},
+		{"2", "-1", "-1"},
+		{"-1", "2", "-1"},
+		{"0", "-1", "0"},
+		{"0", "0", "0"},
+		{"-1", "1", "-1"},
+		{"1", "-1", "-1"},
+		{"1.23", "1.2300", "1.2300"},
+		{"1.23", "-1.2300", "-1.2300"},
 	}
 	for _, tt := range tests {
 		d := MustParse(tt.d)
 		e := MustParse(tt.e)
-		got := d.Min(e)
+		got := d.MinAbs(e)
 		want := MustParse(tt.want)
 		if got != want {
-			t.Errorf("%q.Min(%q) = %q, want %q", d, e, got, want)
+			t.Errorf("%q.MinAbs(%q) = %q, want %q", d, e, got, want)
 		}
 	}
 }
@@ -6261,6 +9724,116 @@ func TestDecimal_Clamp(t *testing.T) {
 	})
 }
 
+func TestDecimal_Clamp64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d      string
+			lo, hi uint64
+			want   string
+		}{
+			{"-1", 0, 0, "0"},
+			{"-1", 5, 10, "5"},
+			{"0", 0, 10, "0"},
+			{"0.000", 0, 10, "0.000"},
+			{"4.999", 5, 10, "5"},
+			{"5.000", 5, 10, "5.000"},
+			{"5.001", 5, 10, "5.001"},
+			{"9.999", 5, 10, "9.999"},
+			{"10.00", 5, 10, "10.00"},
+			{"10.01", 5, 10, "10"},
+			{"0.0000000000000000001", 0, 0, "0"},
+			{"9999999999999999999", 0, math.MaxUint64, "9999999999999999999"},
+			{"9999999999999999999", 0, 9999999999999999998, "9999999999999999998"},
+			{"9999999999999999999", 9999999999999999999, math.MaxUint64, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := d.Clamp64(tt.lo, tt.hi)
+			if err != nil {
+				t.Errorf("%q.Clamp64(%v, %v) failed: %v", d, tt.lo, tt.hi, err)
+				continue
+			}
+			want := MustParse(tt.want)
+			if got != want {
+				t.Errorf("%q.Clamp64(%v, %v) = %q, want %q", d, tt.lo, tt.hi, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d      string
+			lo, hi uint64
+		}{
+			"range 1":    {"0", 1, 0},
+			"range 2":    {"0", math.MaxUint64, 0},
+			"overflow 1": {"0", 10000000000000000000, math.MaxUint64},
+			"overflow 2": {"9999999999999999999", math.MaxUint64, math.MaxUint64},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				_, err := d.Clamp64(tt.lo, tt.hi)
+				if err == nil {
+					t.Errorf("%q.Clamp64(%v, %v) did not fail", d, tt.lo, tt.hi)
+				}
+			})
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, lo, hi string
+		}{
+			{"0", "0", "0"},
+			{"0", "-1", "1"},
+			{"-1", "-1", "1"},
+			{"1", "-1", "1"},
+			{"1.00", "1", "1.0"},
+			{"0.5", "0.01", "100"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			lo := MustParse(tt.lo)
+			hi := MustParse(tt.hi)
+			err := Validate(d, lo, hi, "amount")
+			if err != nil {
+				t.Errorf("Validate(%q, %q, %q) failed: %v", d, lo, hi, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, lo, hi string
+			want      error
+		}{
+			"below 1":         {"-1.01", "-1", "1", ErrOutOfRange},
+			"below 2":         {"0.009", "0.01", "100", ErrOutOfRange},
+			"above 1":         {"1.000000000000000001", "-1", "1", ErrOutOfRange},
+			"above 2":         {"100.01", "0.01", "100", ErrOutOfRange},
+			"invalid range 1": {"0", "1", "-1", nil},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := MustParse(tt.d)
+				lo := MustParse(tt.lo)
+				hi := MustParse(tt.hi)
+				err := Validate(d, lo, hi, "amount")
+				if err == nil {
+					t.Errorf("Validate(%q, %q, %q) did not fail", d, lo, hi)
+					return
+				}
+				if tt.want != nil && !errors.Is(err, tt.want) {
+					t.Errorf("Validate(%q, %q, %q) = %v, want %v", d, lo, hi, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
 func TestNullDecimal_Interfaces(t *testing.T) {
 	var n any = NullDecimal{}
 	_, ok := n.(driver.Valuer)
@@ -6288,6 +9861,293 @@ func TestNullDecimal_Scan(t *testing.T) {
 	})
 }
 
+func TestRange_All(t *testing.T) {
+	tests := []struct {
+		from, to, step string
+		inclusive      bool
+		want           []string
+	}{
+		{"0", "1", "0.25", false, []string{"0", "0.25", "0.50", "0.75"}},
+		{"0", "1", "0.25", true, []string{"0", "0.25", "0.50", "0.75", "1.00"}},
+		{"0", "1", "0.3", true, []string{"0", "0.3", "0.6", "0.9"}},
+		{"1", "0", "-0.5", true, []string{"1", "0.5", "0.0"}},
+		{"1", "0", "-0.5", false, []string{"1", "0.5"}},
+		{"1", "1", "1", true, []string{"1"}},
+		{"1", "1", "1", false, nil},
+		{"0", "1", "0", true, nil},
+		{"0", "1", "-1", true, nil},
+		{"1", "0", "1", true, nil},
+		{"9999999999999999998", "9999999999999999999", "0.5", true, []string{"9999999999999999998"}},
+	}
+	for _, tt := range tests {
+		r := Range{
+			From:      MustParse(tt.from),
+			To:        MustParse(tt.to),
+			Step:      MustParse(tt.step),
+			Inclusive: tt.inclusive,
+		}
+		var got []Decimal
+		for d := range r.All() {
+			got = append(got, d)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%v.All() yielded %v values, want %v", r, len(got), len(tt.want))
+			continue
+		}
+		for i := range got {
+			want := MustParse(tt.want[i])
+			if got[i] != want {
+				t.Errorf("%v.All()[%v] = %q, want %q", r, i, got[i], want)
+			}
+		}
+	}
+
+	t.Run("break", func(t *testing.T) {
+		r := Range{From: MustParse("0"), To: MustParse("10"), Step: MustParse("1")}
+		var n int
+		for range r.All() {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+		if n != 3 {
+			t.Errorf("%v.All() yielded %v values after break, want 3", r, n)
+		}
+	})
+}
+
+func TestAtomicDecimal(t *testing.T) {
+	t.Run("zero", func(t *testing.T) {
+		var a AtomicDecimal
+		got := a.Load()
+		if got != (Decimal{}) {
+			t.Errorf("AtomicDecimal.Load() = %q, want %q", got, Decimal{})
+		}
+		if !a.CompareAndSwap(Decimal{}, MustParse("1")) {
+			t.Errorf("AtomicDecimal.CompareAndSwap(%q, %q) = false, want true", Decimal{}, MustParse("1"))
+		}
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		tests := []struct {
+			stored, old, new string
+			want             bool
+		}{
+			{"1.23", "1.23", "4.56", true},
+			{"1.23", "1.230", "4.56", false},
+			{"1.23", "-1.23", "4.56", false},
+			{"0", "0", "0.00", true},
+		}
+		for _, tt := range tests {
+			var a AtomicDecimal
+			stored := MustParse(tt.stored)
+			a.Store(stored)
+			old := MustParse(tt.old)
+			new := MustParse(tt.new)
+			ok := a.CompareAndSwap(old, new)
+			if ok != tt.want {
+				t.Errorf("AtomicDecimal(%q).CompareAndSwap(%q, %q) = %v, want %v", stored, old, new, ok, tt.want)
+			}
+			want := stored
+			if tt.want {
+				want = new
+			}
+			got := a.Load()
+			if got != want {
+				t.Errorf("AtomicDecimal(%q).Load() = %q, want %q", stored, got, want)
+			}
+		}
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var a AtomicDecimal
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					for {
+						old := a.Load()
+						new, err := old.Add(MustParse("0.01"))
+						if err != nil {
+							t.Errorf("%q.Add(%q) failed: %v", old, MustParse("0.01"), err)
+							return
+						}
+						if a.CompareAndSwap(old, new) {
+							break
+						}
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		got := a.Load()
+		want := MustParse("8.00")
+		if got != want {
+			t.Errorf("AtomicDecimal.Load() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestPercentageFromDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0%"},
+			{"0.05", "5%"},
+			{"0.125", "12.5%"},
+			{"-0.0025", "-0.25%"},
+			{"1", "100%"},
+			{"1.5", "150%"},
+			{"0.0000000000000000001", "0.00000000000000001%"},
+			{"99999999999999999", "9999999999999999900%"},
+		}
+		for _, tt := range tests {
+			d := MustParse(tt.d)
+			got, err := PercentageFromDecimal(d)
+			if err != nil {
+				t.Errorf("PercentageFromDecimal(%q) failed: %v", d, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("PercentageFromDecimal(%q) = %q, want %q", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"100000000000000000", "999999999999999999.9"}
+		for _, tt := range tests {
+			d := MustParse(tt)
+			_, err := PercentageFromDecimal(d)
+			if err == nil {
+				t.Errorf("PercentageFromDecimal(%q) did not fail", d)
+			}
+		}
+	})
+}
+
+func TestParsePercentage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{"5%", "5%"},
+			{"5", "5%"},
+			{"-12.50%", "-12.50%"},
+			{"1e2%", "100%"},
+		}
+		for _, tt := range tests {
+			got, err := ParsePercentage(tt.s)
+			if err != nil {
+				t.Errorf("ParsePercentage(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParsePercentage(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "%", "5%%", "5 %", "%5", "abc%"}
+		for _, tt := range tests {
+			_, err := ParsePercentage(tt)
+			if err == nil {
+				t.Errorf("ParsePercentage(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestPercentage_ToDecimal(t *testing.T) {
+	tests := []struct {
+		p, want string
+	}{
+		{"0%", "0.00"},
+		{"5%", "0.05"},
+		{"12.5%", "0.125"},
+		{"-0.25%", "-0.0025"},
+		{"100%", "1.00"},
+		{"9999999999999999999%", "99999999999999999.99"},
+		{"0.00000000000000001%", "0.0000000000000000001"},
+		{"0.000000000000000015%", "0.0000000000000000002"},
+		{"0.000000000000000025%", "0.0000000000000000002"},
+		{"0.0000000000000000001%", "0.0000000000000000000"},
+	}
+	for _, tt := range tests {
+		p, err := ParsePercentage(tt.p)
+		if err != nil {
+			t.Errorf("ParsePercentage(%q) failed: %v", tt.p, err)
+			continue
+		}
+		got := p.ToDecimal()
+		want := MustParse(tt.want)
+		if got != want {
+			t.Errorf("%q.ToDecimal() = %q, want %q", p, got, want)
+		}
+	}
+}
+
+func TestPercentage_MarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			p, want string
+		}{
+			{"0", `{"Rate":"0%"}`},
+			{"5", `{"Rate":"5%"}`},
+			{"12.5%", `{"Rate":"12.5%"}`},
+			{"-0.25%", `{"Rate":"-0.25%"}`},
+		}
+		for _, tt := range tests {
+			p, err := ParsePercentage(tt.p)
+			if err != nil {
+				t.Errorf("ParsePercentage(%q) failed: %v", tt.p, err)
+				continue
+			}
+			v := struct{ Rate Percentage }{p}
+			got, err := json.Marshal(v)
+			if err != nil {
+				t.Errorf("json.Marshal(%v) failed: %v", v, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%v) = %s, want %s", v, got, tt.want)
+			}
+			v.Rate = Percentage{}
+			err = json.Unmarshal(got, &v)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", got, err)
+				continue
+			}
+			if v.Rate != p {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", got, v.Rate, p)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"invalid 1": "",
+			"invalid 2": "%",
+			"invalid 3": "12.5%%",
+			"invalid 4": "abc",
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				var p Percentage
+				err := p.UnmarshalText([]byte(tt))
+				if err == nil {
+					t.Errorf("UnmarshalText(%q) did not fail", tt)
+				}
+			})
+		}
+	})
+}
+
 /******************************************************
 * Fuzzing
 ******************************************************/
@@ -6614,6 +10474,52 @@ func FuzzDecimal_Mul(f *testing.F) {
 	)
 }
 
+func FuzzDecimal_MulRound(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			if scale < MinScale || scale > MaxScale {
+				t.Skip()
+				return
+			}
+
+			for mode := RoundHalfEven; mode <= RoundFloor; mode++ {
+				got, err := d.mulRoundFint(e, scale, mode)
+				if err != nil {
+					continue
+				}
+
+				want, err := d.mulRoundBint(e, scale, mode)
+				if err != nil {
+					t.Errorf("mulRoundBint(%q, %q, %v, %v) failed: %v", d, e, scale, mode, err)
+					continue
+				}
+
+				if got != want {
+					t.Errorf("mulRoundBint(%q, %q, %v, %v) = %q, whereas mulRoundFint(%q, %q, %v, %v) = %q", d, e, scale, mode, want, d, e, scale, mode, got)
+				}
+			}
+		},
+	)
+}
+
 func FuzzDecimal_Mul_Prod(f *testing.F) {
 	for _, d := range corpus {
 		for _, e := range corpus {
@@ -7036,6 +10942,52 @@ func FuzzDecimal_Quo(f *testing.F) {
 	)
 }
 
+func FuzzDecimal_QuoRound(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			if e.IsZero() || scale < MinScale || scale > MaxScale {
+				t.Skip()
+				return
+			}
+
+			for mode := RoundHalfEven; mode <= RoundFloor; mode++ {
+				got, err := d.quoRoundFint(e, scale, mode)
+				if err != nil {
+					continue
+				}
+
+				want, err := d.quoRoundBint(e, scale, mode)
+				if err != nil {
+					t.Errorf("quoRoundBint(%q, %q, %v, %v) failed: %v", d, e, scale, mode, err)
+					continue
+				}
+
+				if got != want {
+					t.Errorf("quoRoundBint(%q, %q, %v, %v) = %q, whereas quoRoundFint(%q, %q, %v, %v) = %q", d, e, scale, mode, want, d, e, scale, mode, got)
+				}
+			}
+		},
+	)
+}
+
 func FuzzDecimal_QuoRem(f *testing.F) {
 	for _, d := range corpus {
 		for _, e := range corpus {
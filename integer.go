@@ -183,6 +183,40 @@ func (x fint) rshDown(shift int) fint {
 	return x / y
 }
 
+// rshMode (Right Shift) calculates x / 10^shift and rounds result using
+// the given rounding mode.
+// The neg flag is the sign of the number that x is the absolute value of.
+func (x fint) rshMode(shift int, neg bool, mode RoundingMode) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		if mode.roundUp(neg, false, true, -1) {
+			return 1
+		}
+		return 0
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	y = y >> 1   // y = y / 2, which is safe as y is a multiple of 10
+	h := 0
+	switch {
+	case r < y:
+		h = -1
+	case r > y:
+		h = 1
+	}
+	if mode.roundUp(neg, z.isOdd(), r != 0, h) {
+		z++
+	}
+	return z
+}
+
 // prec returns length of x in decimal digits.
 // prec assumes that 0 has no digits.
 func (x fint) prec() int {
@@ -754,6 +788,14 @@ var bnlog10 = [...]*bint{
 	mustParseBint("22795592420641052271778115401375205655250905"),
 }
 
+// bhpi is a half of π represented with doubled scale, where bhpi = round(π / 2 * 10^82).
+// The extra digits keep the argument reduction of trigonometric functions accurate
+// for decimals with up to 19 digits in the integer part.
+var bhpi = mustParseBint("15707963267948966192313216916397514420985846996875529104874722961539082031431044993")
+
+// bsqrt2pi is the square root of 2π, where bsqrt2pi = round(√(2π) * 10^41).
+var bsqrt2pi = mustParseBint("250662827463100050241576528481104525300699")
+
 // mustParseBint converts a string to *big.Int, panicking on error.
 // Use only for package variable initialization and test code!
 func mustParseBint(s string) *bint {
@@ -887,6 +929,12 @@ func (z *bint) quoRem(x, y, r *bint) {
 	(*big.Int)(z).QuoRem((*big.Int)(x), (*big.Int)(y), (*big.Int)(r))
 }
 
+// sqrt calculates z = ⌊√x⌋.
+// If x is negative, the result is unpredictable.
+func (z *bint) sqrt(x *bint) {
+	(*big.Int)(z).Sqrt((*big.Int)(x))
+}
+
 func (z *bint) isOdd() bool {
 	return (*big.Int)(z).Bit(0) != 0
 }
@@ -973,6 +1021,38 @@ func (z *bint) rshHalfEven(x *bint, shift int) {
 	}
 }
 
+// rshMode (Right Shift) calculates z = x / 10^shift and rounds result
+// using the given rounding mode.
+// The neg flag is the sign of the number that x is the absolute value of.
+func (z *bint) rshMode(x *bint, shift int, neg bool, mode RoundingMode) {
+	// Special cases
+	switch {
+	case x.sign() == 0:
+		z.setFint(0)
+		return
+	case shift <= 0:
+		z.setBint(x)
+		return
+	}
+	// General case
+	var y, r *bint
+	r = getBint()
+	defer putBint(r)
+	if shift < len(bpow10) {
+		y = bpow10[shift]
+	} else {
+		y = getBint()
+		defer putBint(y)
+		y.pow10(shift)
+	}
+	z.quoRem(x, y, r)
+	inexact := r.sign() != 0
+	r.dbl(r) // r = r * 2
+	if mode.roundUp(neg, z.isOdd(), inexact, r.cmp(y)) {
+		z.inc(z) // z = z + 1
+	}
+}
+
 // prec returns length of z in decimal digits.
 // prec assumes that 0 has no digits.
 // If z is negative, the result is unpredictable.
@@ -350,6 +350,72 @@ func TestFint_rshDown(t *testing.T) {
 	}
 }
 
+func TestFint_rshMode(t *testing.T) {
+	cases := []struct {
+		x     fint
+		shift int
+		neg   bool
+		mode  RoundingMode
+		want  fint
+	}{
+		// Negative shift
+		{15, -1, false, RoundUp, 15},
+
+		// Rounding
+		{15, 1, false, RoundHalfEven, 2},
+		{25, 1, false, RoundHalfEven, 2},
+		{25, 1, false, RoundHalfUp, 3},
+		{25, 1, false, RoundHalfDown, 2},
+		{26, 1, false, RoundHalfDown, 3},
+		{24, 1, false, RoundHalfUp, 2},
+		{21, 1, false, RoundUp, 3},
+		{20, 1, false, RoundUp, 2},
+		{29, 1, false, RoundDown, 2},
+		{21, 1, false, RoundCeiling, 3},
+		{21, 1, true, RoundCeiling, 2},
+		{21, 1, false, RoundFloor, 2},
+		{21, 1, true, RoundFloor, 3},
+		{20, 1, true, RoundFloor, 2},
+
+		// Large shifts
+		{0, 19, false, RoundUp, 0},
+		{1, 19, false, RoundUp, 1},
+		{1, 20, false, RoundUp, 1},
+		{1, 20, true, RoundCeiling, 0},
+		{1, 20, true, RoundFloor, 1},
+		{1, 20, false, RoundHalfUp, 0},
+		{maxFint, 19, false, RoundHalfEven, 1},
+		{maxFint, 19, false, RoundDown, 0},
+		{maxFint, 20, false, RoundHalfUp, 0},
+		{5_000_000_000_000_000_000, 19, false, RoundHalfUp, 1},
+		{5_000_000_000_000_000_000, 19, false, RoundHalfDown, 0},
+		{5_000_000_000_000_000_000, 19, false, RoundHalfEven, 0},
+		{math.MaxUint64, 19, false, RoundUp, 2},
+		{math.MaxUint64, 19, false, RoundHalfDown, 2},
+	}
+	for _, tt := range cases {
+		got := tt.x.rshMode(tt.shift, tt.neg, tt.mode)
+		if got != tt.want {
+			t.Errorf("%v.rshMode(%v, %v, %v) = %v, want %v", tt.x, tt.shift, tt.neg, tt.mode, got, tt.want)
+		}
+	}
+
+	// Consistency with other rounding helpers
+	for x := fint(0); x < 1_000; x++ {
+		for shift := 0; shift < 4; shift++ {
+			if got, want := x.rshMode(shift, false, RoundHalfEven), x.rshHalfEven(shift); got != want {
+				t.Errorf("%v.rshMode(%v, false, %v) = %v, want %v", x, shift, RoundHalfEven, got, want)
+			}
+			if got, want := x.rshMode(shift, false, RoundUp), x.rshUp(shift); got != want {
+				t.Errorf("%v.rshMode(%v, false, %v) = %v, want %v", x, shift, RoundUp, got, want)
+			}
+			if got, want := x.rshMode(shift, false, RoundDown), x.rshDown(shift); got != want {
+				t.Errorf("%v.rshMode(%v, false, %v) = %v, want %v", x, shift, RoundDown, got, want)
+			}
+		}
+	}
+}
+
 func TestFint_prec(t *testing.T) {
 	cases := []struct {
 		x    fint
@@ -695,6 +761,51 @@ func TestBint_rshHalfEven(t *testing.T) {
 	}
 }
 
+func TestBint_rshMode(t *testing.T) {
+	cases := []struct {
+		z     string
+		shift int
+		neg   bool
+		mode  RoundingMode
+		want  string
+	}{
+		// Negative shift
+		{"15", -1, false, RoundUp, "15"},
+
+		// Rounding
+		{"15", 1, false, RoundHalfEven, "2"},
+		{"25", 1, false, RoundHalfEven, "2"},
+		{"25", 1, false, RoundHalfUp, "3"},
+		{"25", 1, false, RoundHalfDown, "2"},
+		{"26", 1, false, RoundHalfDown, "3"},
+		{"21", 1, false, RoundUp, "3"},
+		{"20", 1, false, RoundUp, "2"},
+		{"29", 1, false, RoundDown, "2"},
+		{"21", 1, false, RoundCeiling, "3"},
+		{"21", 1, true, RoundCeiling, "2"},
+		{"21", 1, false, RoundFloor, "2"},
+		{"21", 1, true, RoundFloor, "3"},
+
+		// Large shifts
+		{"0", 100, false, RoundUp, "0"},
+		{"1", 100, false, RoundUp, "1"},
+		{"1", 100, false, RoundHalfUp, "0"},
+		{"15000000000000000000", 19, false, RoundHalfDown, "1"},
+		{"15000000000000000000", 19, false, RoundHalfUp, "2"},
+		{"18446744073709551615", 19, false, RoundDown, "1"},
+		{"18446744073709551615", 19, false, RoundUp, "2"},
+		{"18446744073709551615", 20, false, RoundUp, "1"},
+	}
+	for _, tt := range cases {
+		got := mustParseBint(tt.z)
+		got.rshMode(got, tt.shift, tt.neg, tt.mode)
+		want := mustParseBint(tt.want)
+		if got.cmp(want) != 0 {
+			t.Errorf("%v.rshMode(%v, %v, %v) = %v, want %v", tt.z, tt.shift, tt.neg, tt.mode, got, want)
+		}
+	}
+}
+
 func TestBint_lsh(t *testing.T) {
 	cases := []struct {
 		z     string
@@ -716,6 +827,29 @@ func TestBint_lsh(t *testing.T) {
 	}
 }
 
+func TestBint_sqrt(t *testing.T) {
+	cases := []struct {
+		x, want string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"3", "1"},
+		{"4", "2"},
+		{"99", "9"},
+		{"100", "10"},
+		{"200000000000000000000000000000000000000", "14142135623730950488"},
+	}
+	for _, tt := range cases {
+		x := mustParseBint(tt.x)
+		got := getBint()
+		got.sqrt(x)
+		want := mustParseBint(tt.want)
+		if got.cmp(want) != 0 {
+			t.Errorf("sqrt(%v) = %v, want %v", tt.x, got, want)
+		}
+	}
+}
+
 func TestBint_prec(t *testing.T) {
 	cases := []struct {
 		z    string